@@ -0,0 +1,152 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// jwtKeyBits is the size of the JWT signing keys generated by
+// `gapic-showcase run --auth-jwt`.
+const jwtKeyBits = 2048
+
+// authSection is the schema of the `auth` section of the server
+// configuration file, giving the credentials that calls must carry as for
+// --auth-token. For example:
+//
+//	auth:
+//	  tokens: [secret]
+//	  api_keys: ["key:Echo,Identity"]
+//	  jwt:
+//	    key: jwt.pem
+type authSection struct {
+	Tokens  []string `mapstructure:"tokens"`
+	APIKeys []string `mapstructure:"api_keys"`
+	JWT     struct {
+		Enabled bool   `mapstructure:"enabled"`
+		Key     string `mapstructure:"key"`
+	} `mapstructure:"jwt"`
+}
+
+func init() {
+	registerConfigSection("auth", configSection{load: loadAuthSection, validate: validateAuth})
+}
+
+// loadAuthSection reads the auth section in v into config.
+func loadAuthSection(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &authSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "tokens", "auth-token", func() { config.authTokens = section.Tokens })
+	overrideFromFile(v, flags, "api_keys", "auth-api-key", func() { config.authAPIKeys = section.APIKeys })
+	overrideFromFile(v, flags, "jwt.enabled", "auth-jwt", func() { config.authJWT = section.JWT.Enabled })
+	overrideFromFile(v, flags, "jwt.key", "auth-jwt-key", func() { config.authJWTKey = section.JWT.Key })
+	return nil
+}
+
+// validateAuth checks the credentials that calls must carry.
+func validateAuth(config *RuntimeConfig) error {
+	if _, err := parseCredentials(config.authTokens); err != nil {
+		return fmt.Errorf("auth tokens: %v", err)
+	}
+	if _, err := parseCredentials(config.authAPIKeys); err != nil {
+		return fmt.Errorf("auth API keys: %v", err)
+	}
+	if config.authJWTKey != "" {
+		if _, err := loadJWTKey(config.authJWTKey); err != nil {
+			return fmt.Errorf("JWT key: %v", err)
+		}
+	}
+	return nil
+}
+
+// parseCredentials parses credentials given as for --auth-token,
+// checking that the services they grant access to exist.
+func parseCredentials(specs []string) ([]server.Credential, error) {
+	credentials := []server.Credential{}
+	for _, spec := range specs {
+		c, err := server.ParseCredential(spec)
+		if err != nil {
+			return nil, err
+		}
+		for i, name := range c.Services {
+			if c.Services[i] = canonicalServiceName(name); c.Services[i] == "" {
+				return nil, fmt.Errorf("unknown service %q: must be one of %s", name, strings.Join(showcaseServices, ", "))
+			}
+		}
+		credentials = append(credentials, c)
+	}
+	return credentials, nil
+}
+
+// authConfig returns the credentials that calls must carry, generating
+// a JWT signing key if one is needed but none was given.
+func (config *RuntimeConfig) authConfig() (server.AuthConfig, error) {
+	auth := server.AuthConfig{}
+	var err error
+	if auth.Tokens, err = parseCredentials(config.authTokens); err != nil {
+		return auth, fmt.Errorf("auth tokens: %v", err)
+	}
+	if auth.APIKeys, err = parseCredentials(config.authAPIKeys); err != nil {
+		return auth, fmt.Errorf("auth API keys: %v", err)
+	}
+	switch {
+	case config.authJWTKey != "":
+		if auth.JWTKey, err = loadJWTKey(config.authJWTKey); err != nil {
+			return auth, fmt.Errorf("JWT key: %v", err)
+		}
+	case config.authJWT:
+		if auth.JWTKey, err = rsa.GenerateKey(rand.Reader, jwtKeyBits); err != nil {
+			return auth, fmt.Errorf("JWT key: %v", err)
+		}
+	}
+	return auth, nil
+}
+
+// loadJWTKey reads an RSA private key in PEM format, either PKCS #1 or
+// PKCS #8.
+func loadJWTKey(path string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an RSA private key", path)
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// captureFormats lists the accepted values for the format of the
+// capture files written by `gapic-showcase run --record` and read by
+// `gapic-showcase run --replay`.
+var captureFormats = []string{server.CaptureFormatJSONL, server.CaptureFormatProto}
+
+// captureSection is the schema of the `record` and `replay` sections of
+// the server configuration file, giving the capture files written by
+// --record and read by --replay. For example:
+//
+//	record:
+//	  file: capture.jsonl
+//	  format: jsonl
+//	replay:
+//	  file: known-good.jsonl
+type captureSection struct {
+	File   string `mapstructure:"file"`
+	Format string `mapstructure:"format"`
+}
+
+func init() {
+	registerConfigSection("record", configSection{load: loadRecordSection, validate: validateRecord})
+	registerConfigSection("replay", configSection{load: loadReplaySection, validate: validateReplay})
+}
+
+// loadRecordSection reads the record section in v into config.
+func loadRecordSection(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &captureSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "file", "record", func() { config.recordFile = section.File })
+	overrideFromFile(v, flags, "format", "record-format", func() { config.recordFormat = section.Format })
+	return nil
+}
+
+// loadReplaySection reads the replay section in v into config.
+func loadReplaySection(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &captureSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "file", "replay", func() { config.replayFile = section.File })
+	overrideFromFile(v, flags, "format", "replay-format", func() { config.replayFormat = section.Format })
+	return nil
+}
+
+// validateRecord checks the capture file to record to.
+func validateRecord(config *RuntimeConfig) error {
+	if config.recordFile != "" && !contains(captureFormats, config.recordFormat) {
+		return fmt.Errorf("unknown record format %q: must be one of %s", config.recordFormat, strings.Join(captureFormats, ", "))
+	}
+	return nil
+}
+
+// validateReplay checks the capture file to replay.
+func validateReplay(config *RuntimeConfig) error {
+	if config.replayFile != "" && !contains(captureFormats, config.replayFormat) {
+		return fmt.Errorf("unknown replay format %q: must be one of %s", config.replayFormat, strings.Join(captureFormats, ", "))
+	}
+	if config.replayFile != "" && config.replayFile == config.recordFile {
+		return fmt.Errorf("cannot record to the file being replayed: %s", config.replayFile)
+	}
+	return nil
+}
+
+// captureFile is the file to which a recorder writes the calls served
+// by Showcase.
+type captureFile struct {
+	*os.File
+	recorder *server.Recorder
+}
+
+// startRecording creates the file at path and registers an observer on
+// backend that writes every call to it in the given format. The file is
+// to be closed once the server has shut down.
+func startRecording(path, format string, backend *services.Backend) (*captureFile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	recorder, err := server.NewRecorder(f, format)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	backend.ObserverRegistry.RegisterUnaryObserver(recorder)
+	backend.ObserverRegistry.RegisterStreamRequestObserver(recorder)
+	backend.ObserverRegistry.RegisterStreamResponseObserver(recorder)
+	if registry, ok := backend.ObserverRegistry.(server.StreamEndObserverRegistry); ok {
+		registry.RegisterStreamEndObserver(recorder)
+	}
+	stdLog.Printf("Showcase recording calls to %s", path)
+	return &captureFile{File: f, recorder: recorder}, nil
+}
+
+// Close stops the recording and closes the file, reporting any error
+// that interrupted the recording.
+func (c *captureFile) Close() error {
+	c.recorder.Stop()
+	if err := c.recorder.Err(); err != nil {
+		c.File.Close()
+		return fmt.Errorf("recording to %s failed: %v", c.Name(), err)
+	}
+	return c.File.Close()
+}
+
+// loadReplay returns a replayer serving the calls recorded in the
+// capture file at path, written in the given format.
+func loadReplay(path, format string) (*server.Replayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	events, err := server.ReadCapture(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capture file %q: %v", path, err)
+	}
+	replayer, err := server.NewReplayer(events)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capture file %q: %v", path, err)
+	}
+	stdLog.Printf("Showcase replaying %d events from %s", len(events), path)
+	return replayer, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// clockHeaderSection is the schema of the `clock_header` entry of the
+// server configuration file, which lets calls change the server clock
+// through the x-showcase-clock header as --clock-header does. For example:
+//
+//	clock_header: true
+type clockHeaderSection struct {
+	ClockHeader bool `mapstructure:"clock_header"`
+}
+
+func init() {
+	registerConfigSection("clock_header", configSection{scalar: true, load: loadClockHeader})
+}
+
+// loadClockHeader reads the clock_header entry in v into config.
+func loadClockHeader(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &clockHeaderSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "clock_header", "clock-header", func() { config.clockHeader = section.ClockHeader })
+	return nil
+}
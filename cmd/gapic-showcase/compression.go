@@ -0,0 +1,57 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// compressorsSection is the schema of the `compressors` entry of the
+// server configuration file, listing the compressors enabled for requests
+// and responses as for --compressors. For example:
+//
+//	compressors: [gzip, deflate]
+type compressorsSection struct {
+	Compressors []string `mapstructure:"compressors"`
+}
+
+func init() {
+	registerConfigSection("compressors", configSection{scalar: true, load: loadCompressors, validate: validateCompressors})
+}
+
+// loadCompressors reads the compressors entry in v into config.
+func loadCompressors(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &compressorsSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "compressors", "compressors", func() { config.compressors = section.Compressors })
+	return nil
+}
+
+// validateCompressors checks that the enabled compressors are supported.
+func validateCompressors(config *RuntimeConfig) error {
+	for _, name := range config.compressors {
+		if !contains(compression.Supported(), name) {
+			return fmt.Errorf("unknown compressor %q: must be one of %s", name, strings.Join(compression.Supported(), ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// showcaseServices lists the names of the services that can be
+// enabled or disabled in the server configuration.
+var showcaseServices = []string{"Echo", "Identity", "Messaging", "SequenceService", "Testing", "Operations", "Admin"}
+
+// logLevels lists the accepted values for the logging level, from most
+// to least verbose.
+var logLevels = []string{"debug", "info", "error"}
+
+// configFile is the schema of the server configuration file given to
+// `gapic-showcase run --config`, besides the sections registered by the
+// features of the server with registerConfigSection. It may be written in
+// any format supported by viper, such as YAML or JSON. For example:
+//
+//	port: ":7469"
+//	listen: [":7469", "unix:///tmp/showcase.sock"]
+//	shutdown_timeout: 30s
+//	tls:
+//	  ca_cert: ca.pem
+//	  cert: server.pem
+//	  key: server.key
+//	services: [Echo, SequenceService]
+//	logging:
+//	  level: debug
+type configFile struct {
+	Port            string        `mapstructure:"port"`
+	Listen          []string      `mapstructure:"listen"`
+	FallbackPort    string        `mapstructure:"fallback_port"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+	TLS             struct {
+		CACert string `mapstructure:"ca_cert"`
+		Cert   string `mapstructure:"cert"`
+		Key    string `mapstructure:"key"`
+
+		// GenerateSelfSigned is the directory to which
+		// --generate-self-signed writes its certificates.
+		GenerateSelfSigned string `mapstructure:"generate_self_signed"`
+	} `mapstructure:"tls"`
+
+	// Services lists the services to serve. All are served if empty.
+	Services []string `mapstructure:"services"`
+
+	Logging struct {
+		Level string `mapstructure:"level"`
+	} `mapstructure:"logging"`
+}
+
+// configSection is a section of the server configuration file that
+// belongs to a single feature of the server, which registers it from its
+// own file.
+type configSection struct {
+	// scalar is set for sections holding a single value, such as a
+	// list or a boolean, rather than a map of fields.
+	scalar bool
+
+	// load reads the section into config. The fields of the section
+	// are given on their own in v, while the value of a scalar section
+	// is found in v under the section key. Values in the section must
+	// not override those of flags given explicitly in flags.
+	load func(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error
+
+	// validate, if not nil, checks the values of config that the
+	// feature uses, whether they come from the file or not.
+	validate func(config *RuntimeConfig) error
+}
+
+// configSections holds the registered sections by their top-level key.
+var configSections = map[string]configSection{}
+
+// registerConfigSection registers the section of the server
+// configuration file found under key.
+func registerConfigSection(key string, section configSection) {
+	configSections[key] = section
+}
+
+// overrideFromFile applies a value read from the configuration file in v
+// under key, unless it is not set there or the corresponding flag (if
+// any) was given explicitly.
+func overrideFromFile(v *viper.Viper, flags *pflag.FlagSet, key, flag string, apply func()) {
+	if v.IsSet(key) && (flag == "" || !flags.Changed(flag)) {
+		apply()
+	}
+}
+
+// configSectionKeys returns the keys of the registered sections, sorted.
+func configSectionKeys() []string {
+	keys := make([]string, 0, len(configSections))
+	for key := range configSections {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// loadConfigFile reads the server configuration file at path into
+// config. Values set explicitly through flags take precedence over
+// those in the file.
+func loadConfigFile(path string, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("could not read config file %q: %v", path, err)
+	}
+
+	// The registered sections are split from the rest of the file, which
+	// must match configFile exactly.
+	settings := v.AllSettings()
+	sections := map[string]*viper.Viper{}
+	for _, key := range configSectionKeys() {
+		value, ok := settings[key]
+		if !ok {
+			continue
+		}
+		delete(settings, key)
+		fields, ok := value.(map[string]interface{})
+		if configSections[key].scalar {
+			fields = map[string]interface{}{key: value}
+		} else if !ok {
+			return fmt.Errorf("invalid config file %q: %s: must be a map", path, key)
+		}
+		sections[key] = viper.New()
+		sections[key].MergeConfigMap(fields)
+	}
+	v = viper.New()
+	v.MergeConfigMap(settings)
+	file := &configFile{}
+	if err := v.UnmarshalExact(file); err != nil {
+		return fmt.Errorf("invalid config file %q: %v", path, err)
+	}
+
+	override := func(key, flag string, apply func()) {
+		overrideFromFile(v, flags, key, flag, apply)
+	}
+	override("port", "port", func() { config.port = file.Port })
+	override("listen", "listen", func() { config.listen = file.Listen })
+	override("fallback_port", "fallback-port", func() { config.fallbackPort = file.FallbackPort })
+	override("shutdown_timeout", "shutdown-timeout", func() { config.shutdownTimeout = file.ShutdownTimeout })
+	override("tls.ca_cert", "mtls-ca-cert", func() { config.tlsCaCert = file.TLS.CACert })
+	override("tls.cert", "mtls-cert", func() { config.tlsCert = file.TLS.Cert })
+	override("tls.key", "mtls-key", func() { config.tlsKey = file.TLS.Key })
+	override("tls.generate_self_signed", "generate-self-signed", func() { config.selfSignedDir = file.TLS.GenerateSelfSigned })
+	override("services", "", func() { config.services = file.Services })
+	override("logging.level", "", func() { config.logLevel = file.Logging.Level })
+
+	for _, key := range configSectionKeys() {
+		if sections[key] == nil {
+			continue
+		}
+		if err := configSections[key].load(sections[key], flags, config); err != nil {
+			return fmt.Errorf("invalid config file %q: %s: %v", path, key, err)
+		}
+	}
+	return nil
+}
+
+// validate checks that config is self-consistent and that all of its
+// values are acceptable, so that errors are reported before the server
+// starts.
+func (config *RuntimeConfig) validate() error {
+	tlsFiles := []string{config.tlsCaCert, config.tlsCert, config.tlsKey}
+	for _, path := range tlsFiles {
+		if path == "" {
+			continue
+		}
+		if config.selfSignedDir != "" {
+			return fmt.Errorf("TLS: certificates cannot be given along with --generate-self-signed")
+		}
+		if _, err := ioutil.ReadFile(path); err != nil {
+			return fmt.Errorf("TLS: %v", err)
+		}
+	}
+	if (config.tlsCert == "") != (config.tlsKey == "") {
+		return fmt.Errorf("TLS: the server certificate and the server key must be provided together")
+	}
+	if config.tlsCaCert != "" && config.tlsCert == "" {
+		return fmt.Errorf("mTLS: the CA certificate requires a server certificate and key")
+	}
+
+	for _, address := range config.listenAddresses() {
+		if _, _, err := parseListenAddress(address); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range config.services {
+		if canonicalServiceName(name) == "" {
+			return fmt.Errorf("unknown service %q: must be one of %s", name, strings.Join(showcaseServices, ", "))
+		}
+	}
+
+	if config.logLevel != "" && !contains(logLevels, config.logLevel) {
+		return fmt.Errorf("unknown logging level %q: must be one of %s", config.logLevel, strings.Join(logLevels, ", "))
+	}
+
+	if config.shutdownTimeout < 0 {
+		return fmt.Errorf("shutdown timeout must not be negative: %s", config.shutdownTimeout)
+	}
+	for _, key := range configSectionKeys() {
+		if validate := configSections[key].validate; validate != nil {
+			if err := validate(config); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// serviceEnabled returns whether the service with the given name is
+// to be served.
+func (config *RuntimeConfig) serviceEnabled(name string) bool {
+	if len(config.services) == 0 {
+		return true
+	}
+	for _, enabled := range config.services {
+		if canonicalServiceName(enabled) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalServiceName returns the entry in showcaseServices matching
+// name case-insensitively, or the empty string if there is none.
+func canonicalServiceName(name string) string {
+	for _, service := range showcaseServices {
+		if strings.EqualFold(service, name) {
+			return service
+		}
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+)
+
+// writeConfigFile writes contents to a configuration file with the given
+// extension and returns its path.
+func writeConfigFile(t *testing.T, ext, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "showcase"+ext)
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// testFlags returns the flags overriding values of the configuration file
+// in these tests.
+func testFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("run", pflag.ContinueOnError)
+	flags.String("port", ":7469", "")
+	flags.String("record", "", "")
+	return flags
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	path := writeConfigFile(t, ".yaml", `
+port: ":8080"
+services: [Echo, Messaging]
+logging:
+  level: debug
+faults:
+  delay: 100ms
+  fail_rate: 0.5
+  status: ABORTED
+limits:
+  max_page_size: 20
+seed:
+  users:
+    - alias: alice
+      display_name: Alice
+      email: alice@example.com
+  blurbs:
+    - parent: ${alice}/profile
+      user: ${alice}
+      text: Hello
+record:
+  file: capture.jsonl
+  format: jsonl
+state:
+  interval: 5m
+cors:
+  allowed_origins: ["http://localhost:8080"]
+  max_age: 10m
+compressors: [gzip]
+clock_header: true
+`)
+	config := RuntimeConfig{}
+	if err := loadConfigFile(path, testFlags(), &config); err != nil {
+		t.Fatalf("loadConfigFile: %v", err)
+	}
+	if config.port != ":8080" || strings.Join(config.services, ",") != "Echo,Messaging" || config.logLevel != "debug" {
+		t.Errorf("got port %q, services %q and logging level %q", config.port, config.services, config.logLevel)
+	}
+	if config.faults.Delay != 100*time.Millisecond || config.faults.FailRate != 0.5 || config.faults.Code != codes.Aborted {
+		t.Errorf("got faults %+v", config.faults)
+	}
+	if config.maxPageSize != 20 {
+		t.Errorf("got maximum page size %d, want 20", config.maxPageSize)
+	}
+	if len(config.seed.GetUsers()) != 1 || config.seed.GetUsers()[0].GetUser().GetDisplayName() != "Alice" || len(config.seed.GetBlurbs()) != 1 {
+		t.Errorf("got seed %v", config.seed)
+	}
+	if config.recordFile != "capture.jsonl" || config.recordFormat != "jsonl" || config.stateInterval != 5*time.Minute {
+		t.Errorf("got record file %q, record format %q and state interval %s", config.recordFile, config.recordFormat, config.stateInterval)
+	}
+	if strings.Join(config.corsOrigins, ",") != "http://localhost:8080" || config.corsMaxAge != 10*time.Minute {
+		t.Errorf("got CORS origins %q and max age %s", config.corsOrigins, config.corsMaxAge)
+	}
+	if strings.Join(config.compressors, ",") != "gzip" || !config.clockHeader {
+		t.Errorf("got compressors %q and clock header %t", config.compressors, config.clockHeader)
+	}
+	if err := config.validate(); err != nil {
+		t.Errorf("validate: %v", err)
+	}
+}
+
+func TestLoadConfigFile_flagsTakePrecedence(t *testing.T) {
+	path := writeConfigFile(t, ".json", `{"port": ":8080", "limits": {"max_page_size": 20}, "record": {"file": "a.jsonl", "format": "text"}}`)
+	flags := testFlags()
+	if err := flags.Parse([]string{"--port", ":9090", "--record", "b.jsonl"}); err != nil {
+		t.Fatal(err)
+	}
+	config := RuntimeConfig{port: ":9090", recordFile: "b.jsonl"}
+	if err := loadConfigFile(path, flags, &config); err != nil {
+		t.Fatalf("loadConfigFile: %v", err)
+	}
+	if config.port != ":9090" || config.maxPageSize != 20 {
+		t.Errorf("got port %q and maximum page size %d, want :9090 and 20", config.port, config.maxPageSize)
+	}
+	if config.recordFile != "b.jsonl" || config.recordFormat != "text" {
+		t.Errorf("got record file %q and format %q, want b.jsonl and text", config.recordFile, config.recordFormat)
+	}
+}
+
+func TestLoadConfigFile_errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     string
+	}{
+		{"unknown key", "ports: \":8080\"", "ports"},
+		{"unknown nested key", "logging:\n  verbose: true", "verbose"},
+		{"unknown section key", "faults:\n  rate: 0.5", "faults"},
+		{"section not a map", "limits: 20", "limits: must be a map"},
+		{"invalid fault status", "faults:\n  status: BROKEN", "faults: status"},
+		{"invalid seed", "seed:\n  users:\n    - handle: alice", "seed"},
+		{"unknown cors key", "cors:\n  origins: [\"*\"]", "cors"},
+		{"invalid state interval", "state:\n  interval: soon", "state"},
+		{"scalar section of the wrong type", "clock_header: [true]", "clock_header"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfigFile(t, ".yaml", tt.contents)
+			err := loadConfigFile(path, testFlags(), &RuntimeConfig{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("loadConfigFile: got %v, want an error mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuntimeConfig_validateSections(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(config *RuntimeConfig)
+		want  string
+	}{
+		{"negative fault delay", func(config *RuntimeConfig) { config.faults.Delay = -time.Second }, "fault delay"},
+		{"fail rate above 1", func(config *RuntimeConfig) { config.faults.FailRate = 1.5 }, "fail rate"},
+		{"negative page size", func(config *RuntimeConfig) { config.maxPageSize = -1 }, "page size"},
+		{"unknown record format", func(config *RuntimeConfig) { config.recordFile, config.recordFormat = "a.jsonl", "xml" }, "record format"},
+		{"replaying the recorded file", func(config *RuntimeConfig) {
+			config.recordFile, config.recordFormat, config.replayFile, config.replayFormat = "a.jsonl", "jsonl", "a.jsonl", "jsonl"
+		}, "being replayed"},
+		{"negative state interval", func(config *RuntimeConfig) { config.stateInterval = -time.Second }, "state interval"},
+		{"unknown compressor", func(config *RuntimeConfig) { config.compressors = []string{"lz4"} }, "compressor"},
+		{"negative CORS max age", func(config *RuntimeConfig) { config.corsMaxAge = -time.Second }, "CORS max age"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := RuntimeConfig{port: ":7469"}
+			tt.setup(&config)
+			if err := config.validate(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("validate: got %v, want an error mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gmux "github.com/gorilla/mux"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+// corsSection is the schema of the `cors` section of the server
+// configuration file, giving the cross-origin calls accepted by the REST
+// and gRPC-Web endpoints as for --cors-origin. For example:
+//
+//	cors:
+//	  allowed_origins: ["http://localhost:8080"]
+//	  allowed_headers: [x-my-header]
+//	  exposed_headers: [x-my-header]
+//	  max_age: 10m
+type corsSection struct {
+	AllowedOrigins []string      `mapstructure:"allowed_origins"`
+	AllowedHeaders []string      `mapstructure:"allowed_headers"`
+	ExposedHeaders []string      `mapstructure:"exposed_headers"`
+	MaxAge         time.Duration `mapstructure:"max_age"`
+}
+
+func init() {
+	registerConfigSection("cors", configSection{load: loadCORSSection, validate: validateCORS})
+}
+
+// loadCORSSection reads the cors section in v into config.
+func loadCORSSection(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &corsSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "allowed_origins", "cors-origin", func() { config.corsOrigins = section.AllowedOrigins })
+	overrideFromFile(v, flags, "allowed_headers", "cors-header", func() { config.corsHeaders = section.AllowedHeaders })
+	overrideFromFile(v, flags, "exposed_headers", "cors-expose-header", func() { config.corsExposedHeaders = section.ExposedHeaders })
+	overrideFromFile(v, flags, "max_age", "cors-max-age", func() { config.corsMaxAge = section.MaxAge })
+	return nil
+}
+
+// validateCORS checks the cross-origin calls to accept.
+func validateCORS(config *RuntimeConfig) error {
+	for _, origin := range config.corsOrigins {
+		if err := validateCORSOrigin(origin); err != nil {
+			return fmt.Errorf("CORS: %v", err)
+		}
+	}
+	if config.corsMaxAge < 0 {
+		return fmt.Errorf("CORS max age must not be negative: %s", config.corsMaxAge)
+	}
+	return nil
+}
+
+// corsDefaultHeaders are the request headers that cross-origin calls may
+// always carry. A trailing "*" matches any suffix.
+var corsDefaultHeaders = []string{
+	"content-type",
+	"content-encoding",
+	"authorization",
+	"x-goog-*",
+	"x-showcase-*",
+	"x-grpc-web",
+	"x-user-agent",
+	"grpc-timeout",
+}
+
+// corsDefaultExposedHeaders are the response headers that cross-origin
+// callers may always read, besides those set on each response.
+var corsDefaultExposedHeaders = []string{
+	"grpc-status",
+	"grpc-message",
+	"grpc-status-details-bin",
+}
+
+// corsSafelistedHeaders are the response headers that browsers always
+// expose, and which need not be listed in Access-Control-Expose-Headers.
+var corsSafelistedHeaders = []string{
+	"Cache-Control",
+	"Content-Language",
+	"Content-Length",
+	"Content-Type",
+	"Expires",
+	"Last-Modified",
+	"Pragma",
+}
+
+// corsMethods are the HTTP methods that preflight requests may ask for.
+var corsMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// validateCORSOrigin checks that origin is "*" or an origin such as
+// "http://localhost:8080".
+func validateCORSOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid origin %q: must be of the form scheme://host[:port]", origin)
+	}
+	return nil
+}
+
+// corsHandler lets browsers call the REST and gRPC-Web endpoints from the
+// allowed origins, answering preflight requests for the routes of router
+// and the methods of grpcWeb and passing all other requests on to next.
+//
+// Origins listed explicitly are echoed back with
+// Access-Control-Allow-Credentials, so that calls may carry cookies or an
+// Authorization header. Origins allowed only by "*" are answered with "*"
+// and without credentials, so that no page may make credentialed calls.
+type corsHandler struct {
+	origins        []string // "*" allows every origin
+	headers        []string // lowercase, "*" may end a pattern
+	exposedHeaders []string
+	maxAge         time.Duration
+
+	router  *gmux.Router
+	grpcWeb *grpc.Server
+	next    http.Handler
+}
+
+func newCORSHandler(config RuntimeConfig, router *gmux.Router, grpcWeb *grpc.Server, next http.Handler) *corsHandler {
+	h := &corsHandler{
+		origins:        config.corsOrigins,
+		exposedHeaders: append(append([]string{}, corsDefaultExposedHeaders...), config.corsExposedHeaders...),
+		maxAge:         config.corsMaxAge,
+		router:         router,
+		grpcWeb:        grpcWeb,
+		next:           next,
+	}
+	for _, header := range append(append([]string{}, corsDefaultHeaders...), config.corsHeaders...) {
+		h.headers = append(h.headers, strings.ToLower(header))
+	}
+	return h
+}
+
+func (h *corsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	w.Header().Add("Vary", "Origin")
+
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		h.preflight(w, r)
+		return
+	}
+	if h.allowOrigin(w, origin) {
+		w = &corsResponseWriter{ResponseWriter: w, exposedHeaders: h.exposedHeaders}
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// preflight answers a preflight request, allowing the method it asks for
+// if that method is served at the requested path, along with the headers
+// it asks for if they are all allowed.
+func (h *corsHandler) preflight(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Access-Control-Request-Method")
+	w.Header().Add("Vary", "Access-Control-Request-Headers")
+	if _, ok := h.originCredentials(r.Header.Get("Origin")); !ok {
+		http.Error(w, fmt.Sprintf("CORS: origin %q is not allowed", r.Header.Get("Origin")), http.StatusForbidden)
+		return
+	}
+
+	methods := h.allowedMethods(r)
+	if len(methods) == 0 {
+		http.Error(w, fmt.Sprintf("CORS: no route for %s", r.URL.Path), http.StatusNotFound)
+		return
+	}
+	method := r.Header.Get("Access-Control-Request-Method")
+	if !contains(methods, method) {
+		w.Header().Set("Allow", strings.Join(methods, ", "))
+		http.Error(w, fmt.Sprintf("CORS: method %s is not served for %s", method, r.URL.Path), http.StatusMethodNotAllowed)
+		return
+	}
+
+	headers := []string{}
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if header == "" {
+			continue
+		}
+		if !h.allowsHeader(header) {
+			http.Error(w, fmt.Sprintf("CORS: header %q is not allowed", header), http.StatusForbidden)
+			return
+		}
+		headers = append(headers, header)
+	}
+
+	h.allowOrigin(w, r.Header.Get("Origin"))
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+	if len(headers) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+	}
+	if h.maxAge > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(h.maxAge/time.Second)))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowedMethods returns the methods served at the path of r, either by
+// a route of h.router or, for POST, by a method of h.grpcWeb.
+func (h *corsHandler) allowedMethods(r *http.Request) []string {
+	methods := []string{}
+	for _, method := range corsMethods {
+		req := r.Clone(r.Context())
+		req.Method = method
+		if h.router.Match(req, &gmux.RouteMatch{}) {
+			methods = append(methods, method)
+		}
+	}
+	if !contains(methods, http.MethodPost) && h.servesGRPCMethod(r.URL.Path) {
+		methods = append(methods, http.MethodPost)
+	}
+	return methods
+}
+
+// servesGRPCMethod returns whether path names a method of h.grpcWeb, as
+// in "/google.showcase.v1beta1.Echo/Echo".
+func (h *corsHandler) servesGRPCMethod(path string) bool {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if h.grpcWeb == nil || len(parts) != 2 {
+		return false
+	}
+	info, ok := h.grpcWeb.GetServiceInfo()[parts[0]]
+	if !ok {
+		return false
+	}
+	for _, method := range info.Methods {
+		if method.Name == parts[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// originCredentials returns whether origin is allowed and, if so, whether
+// its calls may carry credentials, which only explicitly listed origins may.
+func (h *corsHandler) originCredentials(origin string) (credentials, ok bool) {
+	for _, allowed := range h.origins {
+		if allowed != "*" && strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true, true
+		}
+	}
+	return false, contains(h.origins, "*")
+}
+
+// allowOrigin sets the headers allowing origin on w, returning false if
+// origin is not allowed.
+func (h *corsHandler) allowOrigin(w http.ResponseWriter, origin string) bool {
+	credentials, ok := h.originCredentials(origin)
+	if !ok {
+		return false
+	}
+	if !credentials {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		return true
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	return true
+}
+
+func (h *corsHandler) allowsHeader(header string) bool {
+	for _, allowed := range h.headers {
+		if allowed == header || (strings.HasSuffix(allowed, "*") && strings.HasPrefix(header, strings.TrimSuffix(allowed, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// corsResponseWriter exposes the headers of a cross-origin response,
+// including the response metadata, when they are written.
+type corsResponseWriter struct {
+	http.ResponseWriter
+	exposedHeaders []string
+	wroteHeader    bool
+}
+
+func (cw *corsResponseWriter) WriteHeader(code int) {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		exposed := map[string]bool{}
+		for _, header := range cw.exposedHeaders {
+			exposed[strings.ToLower(header)] = true
+		}
+		for header, values := range cw.Header() {
+			if len(values) > 0 && !contains(corsSafelistedHeaders, header) && !strings.HasPrefix(header, "Access-Control-") &&
+				header != "Vary" && header != "Trailer" && !strings.HasPrefix(header, http.TrailerPrefix) {
+				exposed[strings.ToLower(header)] = true
+			}
+		}
+		names := make([]string, 0, len(exposed))
+		for header := range exposed {
+			names = append(names, header)
+		}
+		sort.Strings(names)
+		cw.Header().Set("Access-Control-Expose-Headers", strings.Join(names, ", "))
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *corsResponseWriter) Write(data []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	return cw.ResponseWriter.Write(data)
+}
+
+func (cw *corsResponseWriter) Flush() {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
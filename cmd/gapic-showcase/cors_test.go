@@ -0,0 +1,354 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	gmux "github.com/gorilla/mux"
+	"google.golang.org/grpc"
+)
+
+// newTestCORSHandler returns a CORS handler allowing origins in front of a
+// router serving GET and DELETE on /v1beta1/things/{id}, and of the Echo
+// service over gRPC-Web.
+func newTestCORSHandler(origins ...string) *corsHandler {
+	router := gmux.NewRouter()
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Thing", "thing")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	}
+	router.HandleFunc("/v1beta1/things/{id}", ok).Methods(http.MethodGet, http.MethodDelete)
+	grpcWeb := grpc.NewServer()
+	pb.RegisterEchoServer(grpcWeb, services.NewEchoServer())
+	return newCORSHandler(RuntimeConfig{
+		corsOrigins:        origins,
+		corsHeaders:        []string{"X-Custom", "x-prefix-*"},
+		corsExposedHeaders: []string{"X-Exposed"},
+		corsMaxAge:         time.Minute,
+	}, router, grpcWeb, router)
+}
+
+func TestCORSHandler_preflight(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		path    string
+		method  string
+		headers string
+
+		code        int
+		allowOrigin string
+		credentials string
+		methods     string
+		allowed     string
+	}{
+		{
+			name:    "listed origin",
+			origins: []string{"http://localhost:8080"},
+			origin:  "http://localhost:8080",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodDelete,
+			headers: "Content-Type, X-Custom",
+
+			code:        http.StatusNoContent,
+			allowOrigin: "http://localhost:8080",
+			credentials: "true",
+			methods:     "GET, DELETE",
+			allowed:     "content-type, x-custom",
+		},
+		{
+			name:    "listed origin with a trailing slash",
+			origins: []string{"http://localhost:8080/"},
+			origin:  "http://localhost:8080",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodGet,
+
+			code:        http.StatusNoContent,
+			allowOrigin: "http://localhost:8080",
+			credentials: "true",
+			methods:     "GET, DELETE",
+		},
+		{
+			name:    "any origin",
+			origins: []string{"*"},
+			origin:  "http://example.com",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodGet,
+			headers: "x-prefix-anything",
+
+			code:        http.StatusNoContent,
+			allowOrigin: "*",
+			methods:     "GET, DELETE",
+			allowed:     "x-prefix-anything",
+		},
+		{
+			name:    "listed origin besides any origin",
+			origins: []string{"*", "http://localhost:8080"},
+			origin:  "http://localhost:8080",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodGet,
+
+			code:        http.StatusNoContent,
+			allowOrigin: "http://localhost:8080",
+			credentials: "true",
+			methods:     "GET, DELETE",
+		},
+		{
+			name:    "gRPC-Web method",
+			origins: []string{"*"},
+			origin:  "http://example.com",
+			path:    "/google.showcase.v1beta1.Echo/Expand",
+			method:  http.MethodPost,
+			headers: "x-grpc-web, x-user-agent",
+
+			code:        http.StatusNoContent,
+			allowOrigin: "*",
+			methods:     "POST",
+			allowed:     "x-grpc-web, x-user-agent",
+		},
+		{
+			name:    "origin not allowed",
+			origins: []string{"http://localhost:8080"},
+			origin:  "http://example.com",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodGet,
+
+			code: http.StatusForbidden,
+		},
+		{
+			name:    "no route",
+			origins: []string{"*"},
+			origin:  "http://example.com",
+			path:    "/google.showcase.v1beta1.Echo/Nothing",
+			method:  http.MethodPost,
+
+			code: http.StatusNotFound,
+		},
+		{
+			name:    "method not served",
+			origins: []string{"*"},
+			origin:  "http://example.com",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodPost,
+
+			code: http.StatusMethodNotAllowed,
+		},
+		{
+			name:    "header not allowed",
+			origins: []string{"*"},
+			origin:  "http://example.com",
+			path:    "/v1beta1/things/1",
+			method:  http.MethodGet,
+			headers: "X-Custom, X-Other",
+
+			code: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, tt.path, nil)
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", tt.method)
+			if tt.headers != "" {
+				req.Header.Set("Access-Control-Request-Headers", tt.headers)
+			}
+			w := httptest.NewRecorder()
+			newTestCORSHandler(tt.origins...).ServeHTTP(w, req)
+
+			if w.Code != tt.code {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+			for header, want := range map[string]string{
+				"Access-Control-Allow-Origin":      tt.allowOrigin,
+				"Access-Control-Allow-Credentials": tt.credentials,
+				"Access-Control-Allow-Methods":     tt.methods,
+				"Access-Control-Allow-Headers":     tt.allowed,
+			} {
+				if got := w.Header().Get(header); got != want {
+					t.Errorf("got %s %q, want %q", header, got, want)
+				}
+			}
+			if tt.code == http.StatusNoContent && w.Header().Get("Access-Control-Max-Age") != "60" {
+				t.Errorf("got Access-Control-Max-Age %q, want 60", w.Header().Get("Access-Control-Max-Age"))
+			}
+		})
+	}
+}
+
+func TestCORSHandler_actualRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		origins     []string
+		origin      string
+		allowOrigin string
+		credentials string
+		exposed     string
+	}{
+		{
+			name:        "listed origin",
+			origins:     []string{"http://localhost:8080"},
+			origin:      "http://localhost:8080",
+			allowOrigin: "http://localhost:8080",
+			credentials: "true",
+			exposed:     "grpc-message, grpc-status, grpc-status-details-bin, x-exposed, x-thing",
+		},
+		{
+			name:        "any origin",
+			origins:     []string{"*"},
+			origin:      "http://example.com",
+			allowOrigin: "*",
+			exposed:     "grpc-message, grpc-status, grpc-status-details-bin, x-exposed, x-thing",
+		},
+		{
+			name:    "origin not allowed",
+			origins: []string{"http://localhost:8080"},
+			origin:  "http://example.com",
+		},
+		{
+			name:    "no origin",
+			origins: []string{"*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1beta1/things/1", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			w := httptest.NewRecorder()
+			newTestCORSHandler(tt.origins...).ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK || w.Body.String() != "{}" {
+				t.Fatalf("got status %d and body %q, want the response of the route", w.Code, w.Body)
+			}
+			for header, want := range map[string]string{
+				"Access-Control-Allow-Origin":      tt.allowOrigin,
+				"Access-Control-Allow-Credentials": tt.credentials,
+				"Access-Control-Expose-Headers":    tt.exposed,
+			} {
+				if got := w.Header().Get(header); got != want {
+					t.Errorf("got %s %q, want %q", header, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCORSHandler_allowsHeader(t *testing.T) {
+	h := newTestCORSHandler("*")
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{"content-type", true},
+		{"authorization", true},
+		{"x-goog-api-client", true},
+		{"x-showcase-tenant", true},
+		{"x-grpc-web", true},
+		{"x-custom", true},
+		{"x-prefix-", true},
+		{"x-prefix-anything", true},
+		{"x-prefix", false},
+		{"x-custom-more", false},
+		{"x-goog", false},
+		{"cookie", false},
+	}
+	for _, tt := range tests {
+		if got := h.allowsHeader(tt.header); got != tt.want {
+			t.Errorf("allowsHeader(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestCORSResponseWriter(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		write   func(w *corsResponseWriter)
+		code    int
+		exposed string
+	}{
+		{
+			name:    "WriteHeader",
+			headers: map[string]string{"X-Metadata": "m", "Content-Type": "application/json"},
+			write:   func(w *corsResponseWriter) { w.WriteHeader(http.StatusNotFound) },
+			code:    http.StatusNotFound,
+			exposed: "x-default, x-metadata",
+		},
+		{
+			name:    "Write",
+			headers: map[string]string{"X-Metadata": "m"},
+			write:   func(w *corsResponseWriter) { w.Write([]byte("body")) },
+			code:    http.StatusOK,
+			exposed: "x-default, x-metadata",
+		},
+		{
+			name:    "Flush",
+			headers: map[string]string{"Grpc-Status": "0"},
+			write:   func(w *corsResponseWriter) { w.Flush() },
+			code:    http.StatusOK,
+			exposed: "grpc-status, x-default",
+		},
+		{
+			name: "safelisted, CORS and trailer headers",
+			headers: map[string]string{
+				"Cache-Control":                "no-cache",
+				"Content-Length":               "4",
+				"Access-Control-Allow-Origin":  "*",
+				"Vary":                         "Origin",
+				"Trailer":                      "Grpc-Status",
+				http.TrailerPrefix + "X-Trail": "t",
+			},
+			write:   func(w *corsResponseWriter) { w.WriteHeader(http.StatusOK) },
+			code:    http.StatusOK,
+			exposed: "x-default",
+		},
+		{
+			name:    "headers set after the first write",
+			headers: map[string]string{},
+			write: func(w *corsResponseWriter) {
+				w.WriteHeader(http.StatusAccepted)
+				w.Header().Set("X-Late", "l")
+				w.WriteHeader(http.StatusOK)
+			},
+			code:    http.StatusAccepted,
+			exposed: "x-default",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			w := &corsResponseWriter{ResponseWriter: rec, exposedHeaders: []string{"X-Default"}}
+			for header, value := range tt.headers {
+				w.Header().Set(header, value)
+			}
+			tt.write(w)
+			if rec.Code != tt.code {
+				t.Errorf("got status %d, want %d", rec.Code, tt.code)
+			}
+			if got := rec.Header().Get("Access-Control-Expose-Headers"); got != tt.exposed {
+				t.Errorf("got Access-Control-Expose-Headers %q, want %q", got, tt.exposed)
+			}
+		})
+	}
+}
@@ -17,38 +17,80 @@ package main
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/googleapis/gapic-showcase/server"
+	"github.com/googleapis/gapic-showcase/server/compression"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/server/genrest"
 	"github.com/googleapis/gapic-showcase/server/services"
-	fallback "github.com/googleapis/grpc-fallback-go/server"
 	gmux "github.com/gorilla/mux"
 	"github.com/soheilhy/cmux"
 	"golang.org/x/sync/errgroup"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 // RuntimeConfig has the run-time settings necessary to run the
 // Showcase servers.
 type RuntimeConfig struct {
-	port         string
-	fallbackPort string
-	tlsCaCert    string
-	tlsCert      string
-	tlsKey       string
+	port            string
+	listen          []string // overrides port if not empty
+	fallbackPort    string
+	tlsCaCert       string
+	tlsCert         string
+	tlsKey          string
+	selfSignedDir   string // where --generate-self-signed writes its certificates
+	shutdownTimeout time.Duration
+
+	// The following can only be set through a configuration file.
+	services    []string
+	logLevel    string
+	faults      server.FaultConfig
+	maxPageSize int32
+	seed        *pb.Seed
+
+	recordFile   string
+	recordFormat string
+	replayFile   string
+	replayFormat string
+
+	stateDir      string
+	stateInterval time.Duration
+
+	// Credentials given as for server.ParseCredential.
+	authTokens  []string
+	authAPIKeys []string
+	authJWT     bool
+	authJWTKey  string
+
+	// CORS is enabled once some origin is allowed. Headers may end in
+	// "*" to match any suffix.
+	corsOrigins        []string
+	corsHeaders        []string
+	corsExposedHeaders []string
+	corsMaxAge         time.Duration
+
+	// The compressors enabled for requests and responses, in order of
+	// preference.
+	compressors []string
+
+	// Whether calls may change the server clock, which all tenants
+	// share, through server.ClockHeader.
+	clockHeader bool
 }
 
 // Endpoint defines common operations for any of the various types of
@@ -60,36 +102,103 @@ type Endpoint interface {
 	// implementation.
 	Serve() error
 
-	// Shutdown causes the currently running Endpoint to
-	// terminate. The error it returns depends on the underlying
-	// implementation.
-	Shutdown() error
+	// Shutdown causes the currently running Endpoint to stop
+	// accepting new connections and to wait for in-flight requests
+	// and streams to complete. If ctx is done before they complete,
+	// the remaining connections are closed forcibly and ctx.Err()
+	// is returned. Otherwise, the error it returns depends on the
+	// underlying implementation.
+	Shutdown(ctx context.Context) error
 }
 
-// CreateAllEndpoints returns an Endpoint that can serve gRPC and
-// HTTP/REST connections (on config.port) and gRPC-fallback
-// connections (on config.fallbackPort)
+// cmuxReadTimeout bounds how long the connection multiplexer waits
+// for the first bytes of a new connection in order to match it to an
+// endpoint.
+const cmuxReadTimeout = 10 * time.Second
+
+// CreateAllEndpoints returns an Endpoint that can serve gRPC, gRPC-Web
+// and HTTP/REST connections (on each of config.listenAddresses()) and
+// gRPC-fallback connections (on config.fallbackPort)
 func CreateAllEndpoints(config RuntimeConfig) Endpoint {
-	// Ensure port is of the right form.
-	if !strings.HasPrefix(config.port, ":") {
-		config.port = ":" + config.port
+	// Start listening.
+	addresses := config.listenAddresses()
+	lis, err := listen(addresses)
+	if err != nil {
+		log.Fatalf("Showcase failed to listen on %s: %v", strings.Join(addresses, ", "), err)
+	}
+	for _, address := range addresses {
+		stdLog.Printf("Showcase listening on: %s", address)
 	}
 
-	// Start listening.
-	lis, err := net.Listen("tcp", config.port)
+	// TLS is terminated before multiplexing, so that cmux sees the
+	// plaintext requests.
+	fallbackBackend := fallbackTarget(addresses)
+	var fallbackListener net.Listener
+	tlsConfig, err := config.serverTLSConfig()
 	if err != nil {
-		log.Fatalf("Showcase failed to listen on port '%s': %v", config.port, err)
+		log.Fatalf("Showcase failed to set up TLS: %v", err)
+	}
+	if tlsConfig != nil {
+		// The gRPC-fallback proxy cannot dial TLS, so it reaches the
+		// gRPC server through a plaintext listener only open to
+		// localhost. That listener is not multiplexed: it serves
+		// neither REST nor gRPC-Web.
+		fallbackListener, err = net.Listen("tcp", "localhost:0")
+		if err != nil {
+			log.Fatalf("Showcase failed to listen for the gRPC-fallback proxy: %v", err)
+		}
+		lis = tls.NewListener(lis, tlsConfig)
+		fallbackBackend = fallbackListener.Addr().String()
+		if tlsConfig.ClientCAs != nil {
+			stdLog.Printf("Showcase serving mutual TLS")
+		} else {
+			stdLog.Printf("Showcase serving TLS")
+		}
 	}
-	stdLog.Printf("Showcase listening on port: %s", config.port)
 
-	m := cmux.New(lis)
-	grpcListener := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
-	httpListener := m.Match(cmux.HTTP1Fast())
+	tracker := newTrackingListener(lis)
+	m := cmux.New(tracker)
+	m.SetReadTimeout(cmuxReadTimeout)
+	grpcListener := tracker.Matched(m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc")))
+	httpListener := tracker.Matched(m.Match(cmux.HTTP1Fast()))
 
-	backend := createBackends()
-	gRPCServer := newEndpointGRPC(grpcListener, config, backend)
-	restServer := newEndpointREST(httpListener, backend)
-	cmuxServer := newEndpointMux(m, gRPCServer, restServer)
+	if err := compression.Register(config.compressors); err != nil {
+		log.Fatalf("Showcase failed to register compressors: %v", err)
+	}
+	backend := createBackends(config)
+	restored := false
+	if config.stateDir != "" {
+		restored, err = restoreState(config.stateDir, backend)
+		if err != nil {
+			log.Fatalf("Showcase failed to restore state: %v", err)
+		}
+	}
+	if restored && config.seed != nil {
+		// The seed data was loaded when the snapshot's state was
+		// first created.
+		stdLog.Printf("Showcase skipping seed data: state was restored from a snapshot")
+	} else if config.seed != nil {
+		if err := seedBackend(backend, config.seed); err != nil {
+			log.Fatalf("Showcase failed to load seed data: %v", err)
+		}
+	}
+	gRPCServer := newEndpointGRPC(grpcListener, config, backend, fallbackBackend, fallbackListener)
+	restServer := newEndpointREST(httpListener, config, backend)
+	cmuxServer := newEndpointMux(m, tracker, gRPCServer, restServer)
+	if config.recordFile != "" {
+		capture, err := startRecording(config.recordFile, config.recordFormat, backend)
+		if err != nil {
+			log.Fatalf("Showcase failed to start recording: %v", err)
+		}
+		cmuxServer.closers = append(cmuxServer.closers, capture)
+	}
+	if config.stateDir != "" {
+		snapshots, err := startSnapshots(config.stateDir, config.stateInterval, backend)
+		if err != nil {
+			log.Fatalf("Showcase failed to start saving state: %v", err)
+		}
+		cmuxServer.closers = append(cmuxServer.closers, snapshots)
+	}
 	return cmuxServer
 }
 
@@ -103,13 +212,21 @@ func CreateAllEndpoints(config RuntimeConfig) Endpoint {
 type endpointMux struct {
 	endpoints []Endpoint
 	cmux      cmux.CMux
-	mux       sync.Mutex
+	listener  *trackingListener
+	closers   []io.Closer // closed once all the endpoints are drained
+
+	mux         sync.Mutex
+	stopping    bool
+	stopped     chan struct{}
+	shutdownErr error
 }
 
-func newEndpointMux(cmuxEndpoint cmux.CMux, endpoints ...Endpoint) Endpoint {
+func newEndpointMux(cmuxEndpoint cmux.CMux, lis *trackingListener, endpoints ...Endpoint) *endpointMux {
 	return &endpointMux{
 		endpoints: endpoints,
 		cmux:      cmuxEndpoint,
+		listener:  lis,
+		stopped:   make(chan struct{}),
 	}
 }
 
@@ -117,145 +234,330 @@ func (em *endpointMux) String() string {
 	return "endpoint multiplexer"
 }
 
+// Serve starts all the multiplexed endpoints as well as the
+// multiplexer itself. It blocks until all of them have stopped
+// serving and, if a shutdown was started, until that shutdown has
+// finished draining. Errors caused by the shutdown itself (such as
+// closed listeners) are not reported; if any endpoint fails on its
+// own, all the others are stopped immediately and that failure is
+// returned.
 func (em *endpointMux) Serve() error {
 	g := new(errgroup.Group)
 	for idx, endpt := range em.endpoints {
 		if endpt != nil {
 			stdLog.Printf("Starting endpoint %d: %s", idx, endpt)
-			endpoint := endpt
-			g.Go(func() error {
-				err := endpoint.Serve()
-				err2 := em.Shutdown()
-				if err != nil {
-					return err
-				}
-				return err2
-			})
+			g.Go(em.serveUntilShutdown(endpt.Serve))
 		}
 	}
 	if em.cmux != nil {
 		stdLog.Printf("Starting %s", em)
+		g.Go(em.serveUntilShutdown(em.cmux.Serve))
+	}
+	err := g.Wait()
 
-		g.Go(func() error {
-			err := em.cmux.Serve()
-			err2 := em.Shutdown()
-			if err != nil {
-				return err
-			}
-			return err2
+	if em.isStopping() {
+		<-em.stopped
+		if err == nil {
+			err = em.shutdownErr
+		}
+	}
+	return err
+}
 
-		})
+// serveUntilShutdown wraps serve so that it only reports errors that
+// were not caused by a shutdown of em. An unexpected failure causes
+// em to be shut down without waiting for in-flight requests, since
+// the server as a whole can no longer operate normally.
+func (em *endpointMux) serveUntilShutdown(serve func() error) func() error {
+	return func() error {
+		err := serve()
+		if em.isStopping() {
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		em.Shutdown(ctx)
+		return err
 	}
-	return g.Wait()
 }
 
-func (em *endpointMux) Shutdown() error {
+func (em *endpointMux) isStopping() bool {
 	em.mux.Lock()
 	defer em.mux.Unlock()
+	return em.stopping
+}
 
-	var err error
-	if em.cmux != nil {
-		// TODO: Wait for https://github.com/soheilhy/cmux/pull/69 (due to
-		// https://github.com/soheilhy/cmux/pull/69#issuecomment-712928041.)
-		//
-		// err = em.mux.Close()
-		em.cmux = nil
+// Shutdown closes the shared listener and then drains all the
+// multiplexed endpoints concurrently, after which the files held by
+// the server are closed. Only the first call has any effect;
+// subsequent calls return immediately.
+func (em *endpointMux) Shutdown(ctx context.Context) error {
+	em.mux.Lock()
+	if em.stopping {
+		em.mux.Unlock()
+		return nil
 	}
+	em.stopping = true
+	em.mux.Unlock()
+	defer close(em.stopped)
 
-	for idx, endpt := range em.endpoints {
+	stdLog.Printf("Stopping %s", em)
+
+	// Stop accepting connections, and drop the ones cmux is still
+	// sniffing so that it can finish serving and release the
+	// endpoints' listeners. The error from closing the listener is
+	// ignored because the endpoints may legitimately close it too.
+	em.listener.Close()
+	em.listener.CloseUnmatched()
+
+	g := new(errgroup.Group)
+	for _, endpt := range em.endpoints {
 		if endpt != nil {
-			// TODO: Wait for https://github.com/soheilhy/cmux/pull/69
-			// newErr := endpt.Shutdown()
-			// if err==nil {
-			// 	err = newErr
-			// }
-			em.endpoints[idx] = nil
+			g.Go(func(endpoint Endpoint) func() error {
+				return func() error { return endpoint.Shutdown(ctx) }
+			}(endpt))
 		}
 	}
+	err := g.Wait()
+	for _, closer := range em.closers {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	em.shutdownErr = err
+	stdLog.Printf("Stopped %s: %s", em, message(err))
 	return err
 }
 
 // endpointGRPC is an Endpoint for gRPC connections to the Showcase
 // server.
 type endpointGRPC struct {
-	server         *grpc.Server
-	fallbackServer *fallback.FallbackServer
-	listener       net.Listener
-	mux            sync.Mutex
+	server           *grpc.Server
+	fallbackServer   *http.Server // the gRPC-fallback proxy
+	fallbackProxy    *fallbackProxy
+	health           *health.Server
+	listener         net.Listener
+	fallbackListener net.Listener // nil unless the proxy needs its own
+	proxyListener    *trackingListener
+	mux              sync.Mutex
 }
 
-// createBackends creates services used by both the gRPC and REST servers.
-func createBackends() *services.Backend {
+// createBackends creates services used by both the gRPC and REST
+// servers. Services that are not enabled in config are replaced by
+// stubs that return UNIMPLEMENTED.
+func createBackends(config RuntimeConfig) *services.Backend {
 	logger := &loggerObserver{}
 	observerRegistry := server.ShowcaseObserverRegistry()
 	observerRegistry.RegisterUnaryObserver(logger)
 	observerRegistry.RegisterStreamRequestObserver(logger)
 	observerRegistry.RegisterStreamResponseObserver(logger)
+	metrics := server.NewMetricsObserver()
+	observerRegistry.RegisterUnaryObserver(metrics)
+	observerRegistry.RegisterStreamRequestObserver(metrics)
+	observerRegistry.RegisterStreamResponseObserver(metrics)
+	observerRegistry.(server.StreamEndObserverRegistry).RegisterStreamEndObserver(metrics)
+	deadlines := server.NewDeadlineObserver()
+	observerRegistry.RegisterUnaryObserver(deadlines)
+	observerRegistry.RegisterStreamRequestObserver(deadlines)
+	observerRegistry.RegisterStreamResponseObserver(deadlines)
+
+	// The observer registry comes first so that injected faults and
+	// replayed calls are observed like any other call. The clock is
+	// changed as requested by the call before anything waits on it.
+	faultInjector := server.NewFaultInjector(config.faults)
+	clockUnary, clockStream := server.ClockHeaderGuard{}.UnaryInterceptor, server.ClockHeaderGuard{}.StreamInterceptor
+	if config.clockHeader {
+		clock := server.GetClockInstance()
+		clockUnary, clockStream = clock.UnaryInterceptor, clock.StreamInterceptor
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{observerRegistry.UnaryInterceptor, clockUnary}
+	streamInterceptors := []grpc.StreamServerInterceptor{observerRegistry.StreamInterceptor, clockStream}
 
-	identityServer := services.NewIdentityServer()
-	messagingServer := services.NewMessagingServer(identityServer)
-	return &services.Backend{
-		EchoServer:            services.NewEchoServer(),
-		SequenceServiceServer: services.NewSequenceServer(),
-		IdentityServer:        identityServer,
+	// Credentials are checked before calls are replayed, so that
+	// replayed calls are authenticated like any other call.
+	authConfig, err := config.authConfig()
+	if err != nil {
+		log.Fatalf("Showcase failed to configure authentication: %v", err)
+	}
+	var authenticator *server.Authenticator
+	if authConfig.Enabled() {
+		authenticator = server.NewAuthenticator(authConfig)
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor)
+	}
+	if config.replayFile != "" {
+		// The replayer serves the Showcase services itself, so the
+		// interceptors that follow only apply to the other services.
+		replayer, err := loadReplay(config.replayFile, config.replayFormat)
+		if err != nil {
+			log.Fatalf("Showcase failed to load the calls to replay: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, replayer.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, replayer.StreamInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, faultInjector.UnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, faultInjector.StreamInterceptor)
+	if config.maxPageSize > 0 {
+		unaryInterceptors = append(unaryInterceptors, server.PageSizeLimiter(config.maxPageSize))
+	}
+
+	tenants := services.NewTenants(observerRegistry)
+	messagingServer := tenants.MessagingServer()
+	backend := &services.Backend{
+		EchoServer:            tenants.EchoServer(),
+		SequenceServiceServer: tenants.SequenceServer(),
+		IdentityServer:        tenants.IdentityServer(),
 		MessagingServer:       messagingServer,
-		TestingServer:         services.NewTestingServer(observerRegistry),
-		OperationsServer:      services.NewOperationsServer(messagingServer),
+		TestingServer:         tenants.TestingServer(),
+		OperationsServer:      tenants.OperationsServer(),
+		Tenants:               tenants,
+		HealthServer:          newHealthServer(config),
 		StdLog:                stdLog,
 		ErrLog:                errLog,
 		ObserverRegistry:      observerRegistry,
+		Metrics:               metrics,
+		Deadlines:             deadlines,
+		Authenticator:         authenticator,
+		UnaryInterceptor:      server.ChainUnaryInterceptors(unaryInterceptors...),
+		StreamInterceptor:     server.ChainStreamInterceptors(streamInterceptors...),
 	}
-}
+	backend.AdminServer = services.NewAdminServer(backend)
 
-func newEndpointGRPC(lis net.Listener, config RuntimeConfig, backend *services.Backend) Endpoint {
-	opts := []grpc.ServerOption{
-		grpc.StreamInterceptor(backend.ObserverRegistry.StreamInterceptor),
-		grpc.UnaryInterceptor(backend.ObserverRegistry.UnaryInterceptor),
+	if !config.serviceEnabled("Echo") {
+		backend.EchoServer = &pb.UnimplementedEchoServer{}
+	}
+	if !config.serviceEnabled("SequenceService") {
+		backend.SequenceServiceServer = &pb.UnimplementedSequenceServiceServer{}
+	}
+	if !config.serviceEnabled("Identity") {
+		backend.IdentityServer = &pb.UnimplementedIdentityServer{}
+	}
+	if !config.serviceEnabled("Messaging") {
+		backend.MessagingServer = &pb.UnimplementedMessagingServer{}
+	}
+	if !config.serviceEnabled("Testing") {
+		backend.TestingServer = &pb.UnimplementedTestingServer{}
+	}
+	if !config.serviceEnabled("Operations") {
+		backend.OperationsServer = &lropb.UnimplementedOperationsServer{}
 	}
+	if !config.serviceEnabled("Admin") {
+		backend.AdminServer = &pb.UnimplementedAdminServer{}
+	}
+	return backend
+}
 
-	// load mutual TLS cert/key and root CA cert
-	if config.tlsCaCert != "" && config.tlsCert != "" && config.tlsKey != "" {
-		keyPair, err := tls.LoadX509KeyPair(config.tlsCert, config.tlsKey)
-		if err != nil {
-			log.Fatalf("Failed to load server TLS cert/key with error:%v", err)
-		}
+// newEndpointGRPC returns the gRPC endpoint, along with a gRPC-fallback
+// proxy forwarding to the gRPC server at fallbackBackend. If
+// fallbackListener is not nil, the gRPC server also serves it, so that the
+// proxy can reach it there.
+func newEndpointGRPC(lis net.Listener, config RuntimeConfig, backend *services.Backend, fallbackBackend string, fallbackListener net.Listener) Endpoint {
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(backend.StreamInterceptor),
+		grpc.UnaryInterceptor(backend.UnaryInterceptor),
+		grpc.StatsHandler(compression.StatsHandler{}),
+	)
+	registerServices(s, config, backend)
+
+	eg := &endpointGRPC{
+		server:           s,
+		health:           backend.HealthServer,
+		listener:         lis,
+		fallbackListener: fallbackListener,
+	}
 
-		cert, err := ioutil.ReadFile(config.tlsCaCert)
-		if err != nil {
-			log.Fatalf("Failed to load root CA cert file with error:%v", err)
-		}
+	// The gRPC-fallback proxy is optional: Showcase keeps serving the
+	// other endpoints if it cannot be set up.
+	proxy, err := newFallbackProxy(fallbackBackend)
+	if err != nil {
+		errLog.Printf("Showcase failed to set up the gRPC-fallback proxy: %v", err)
+		return eg
+	}
+	proxyListener, err := net.Listen("tcp", fallbackAddress(config.fallbackPort))
+	if err != nil {
+		errLog.Printf("Showcase failed to listen for gRPC-fallback connections: %v", err)
+		proxy.Close()
+		return eg
+	}
+	eg.fallbackServer = &http.Server{Handler: proxy.Handler()}
+	eg.fallbackProxy = proxy
+	eg.proxyListener = newTrackingListener(proxyListener)
+	return eg
+}
 
-		pool := x509.NewCertPool()
-		pool.AppendCertsFromPEM(cert)
+// fallbackAddress returns the address on which the gRPC-fallback proxy
+// listens, given --fallback-port.
+func fallbackAddress(port string) string {
+	if strings.Contains(port, ":") {
+		return port
+	}
+	return ":" + port
+}
 
-		ta := credentials.NewTLS(&tls.Config{
-			Certificates: []tls.Certificate{keyPair},
-			ClientCAs:    pool,
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-		})
+// listenAddresses returns the addresses to listen on, as given to
+// --listen, defaulting to config.port.
+func (config *RuntimeConfig) listenAddresses() []string {
+	if len(config.listen) > 0 {
+		return config.listen
+	}
+	return []string{config.port}
+}
 
-		opts = append(opts, grpc.Creds(ta))
+// fallbackTarget returns the gRPC target through which the
+// gRPC-fallback proxy reaches the gRPC server, preferring a TCP address
+// to a Unix domain socket.
+func fallbackTarget(addresses []string) string {
+	target := ""
+	for _, address := range addresses {
+		network, addr, err := parseListenAddress(address)
+		if err != nil {
+			continue
+		}
+		if network == "tcp" {
+			_, port, _ := net.SplitHostPort(addr)
+			return "localhost:" + port
+		}
+		if target == "" {
+			if abs, err := filepath.Abs(addr); err == nil {
+				addr = abs
+			}
+			target = "unix://" + addr
+		}
 	}
-	s := grpc.NewServer(opts...)
+	return target
+}
 
-	// Register Services to the server.
-	pb.RegisterEchoServer(s, backend.EchoServer)
-	pb.RegisterSequenceServiceServer(s, backend.SequenceServiceServer)
-	pb.RegisterIdentityServer(s, backend.IdentityServer)
-	pb.RegisterMessagingServer(s, backend.MessagingServer)
-	lropb.RegisterOperationsServer(s, backend.OperationsServer)
-	pb.RegisterTestingServer(s, backend.TestingServer)
+// registerServices registers the enabled Showcase services, the health
+// service and the reflection service to s.
+func registerServices(s *grpc.Server, config RuntimeConfig, backend *services.Backend) {
+	if config.serviceEnabled("Echo") {
+		pb.RegisterEchoServer(s, backend.EchoServer)
+	}
+	if config.serviceEnabled("SequenceService") {
+		pb.RegisterSequenceServiceServer(s, backend.SequenceServiceServer)
+	}
+	if config.serviceEnabled("Identity") {
+		pb.RegisterIdentityServer(s, backend.IdentityServer)
+	}
+	if config.serviceEnabled("Messaging") {
+		pb.RegisterMessagingServer(s, backend.MessagingServer)
+	}
+	if config.serviceEnabled("Operations") {
+		lropb.RegisterOperationsServer(s, backend.OperationsServer)
+	}
+	if config.serviceEnabled("Admin") {
+		pb.RegisterAdminServer(s, backend.AdminServer)
+	}
+	if config.serviceEnabled("Testing") {
+		pb.RegisterTestingServer(s, backend.TestingServer)
+	}
 
-	fb := fallback.NewServer(config.fallbackPort, "localhost"+config.port)
+	healthpb.RegisterHealthServer(s, backend.HealthServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
-
-	return &endpointGRPC{
-		server:         s,
-		fallbackServer: fb,
-		listener:       lis,
-	}
 }
 
 func (eg *endpointGRPC) String() string {
@@ -263,53 +565,134 @@ func (eg *endpointGRPC) String() string {
 }
 
 func (eg *endpointGRPC) Serve() error {
-	defer eg.Shutdown()
-	if eg.fallbackServer != nil {
+	eg.mux.Lock()
+	fallbackServer, server := eg.fallbackServer, eg.server
+	eg.mux.Unlock()
+
+	if server != nil && eg.fallbackListener != nil {
+		// The server stops serving this listener along with the
+		// main one.
+		go server.Serve(eg.fallbackListener)
+	}
+	if fallbackServer != nil {
 		stdLog.Printf("Listening for gRPC-fallback connections")
-		eg.fallbackServer.StartBackground()
+		// The proxy only stops serving when it is shut down along
+		// with the gRPC server, so its error is not worth reporting.
+		go fallbackServer.Serve(eg.proxyListener)
 	}
-	if eg.server != nil {
+	if server != nil {
 		stdLog.Printf("Listening for gRPC connections")
-		return eg.server.Serve(eg.listener)
+		return server.Serve(eg.listener)
 	}
 	return fmt.Errorf("gRPC server not set up")
 }
 
-func (eg *endpointGRPC) Shutdown() error {
+// Shutdown stops the gRPC-fallback proxy before the gRPC server, since
+// in-flight fallback requests are forwarded to the latter. All
+// services are reported as NOT_SERVING while draining.
+func (eg *endpointGRPC) Shutdown(ctx context.Context) error {
 	eg.mux.Lock()
 	defer eg.mux.Unlock()
 
+	if eg.health != nil {
+		eg.health.Shutdown()
+	}
+
+	var err error
 	if eg.fallbackServer != nil {
 		stdLog.Printf("Stopping gRPC-fallback connections")
-		eg.fallbackServer.Shutdown()
+		err = waitUntilDone(ctx, shutdownHTTP(eg.fallbackServer), closeAll(eg.proxyListener))
+		eg.fallbackProxy.Close()
 		eg.fallbackServer = nil
 	}
 
 	if eg.server != nil {
 		stdLog.Printf("Stopping gRPC connections")
-		eg.server.GracefulStop()
+		if newErr := waitUntilDone(ctx, eg.server.GracefulStop, eg.server.Stop); err == nil {
+			err = newErr
+		}
 		eg.server = nil
 	}
 	stdLog.Printf("Stopped gRPC")
-	return nil
+	return err
+}
+
+// waitUntilDone runs the blocking function drain until it returns or
+// until ctx is done, whichever happens first. In the latter case,
+// force (if not nil) is called to abort drain, which is then waited
+// for, and ctx.Err() is returned.
+func waitUntilDone(ctx context.Context, drain func(), force func()) error {
+	done := make(chan struct{})
+	go func() {
+		drain()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		if force != nil {
+			force()
+			<-done
+		}
+		return ctx.Err()
+	}
+}
+
+// shutdownHTTP returns a function that gracefully shuts server down,
+// without a deadline of its own.
+func shutdownHTTP(server *http.Server) func() {
+	return func() { server.Shutdown(context.Background()) }
+}
+
+// closeAll returns a function that closes lis and every connection it
+// has accepted, which aborts a pending Shutdown of the HTTP server
+// serving lis: the requests in flight see their connection go away.
+func closeAll(lis *trackingListener) func() {
+	return func() {
+		lis.Close()
+		lis.CloseUnmatched()
+	}
 }
 
 // endpointREST is an Endpoint for HTTP/REST connections to the Showcase
-// server.
+// server, which also serves gRPC-Web requests.
 type endpointREST struct {
 	server   *http.Server
+	grpcWeb  *grpc.Server
 	listener net.Listener
 	mux      sync.Mutex
 }
 
-func newEndpointREST(lis net.Listener, backend *services.Backend) Endpoint {
+func newEndpointREST(lis net.Listener, config RuntimeConfig, backend *services.Backend) Endpoint {
 	router := gmux.NewRouter()
 	router.HandleFunc("/hello", func(w http.ResponseWriter, _ *http.Request) {
 		w.Write([]byte("GAPIC Showcase: HTTP/REST endpoint using gorilla/mux\n"))
 	})
+	registerHealthHandlers(router, backend.HealthServer)
+	router.Handle("/metrics", backend.Metrics).Methods(http.MethodGet)
+	router.Handle("/deadlines", backend.Deadlines).Methods(http.MethodGet)
 	genrest.RegisterHandlers(router, backend)
+	genrest.RegisterOperationsHandlers(router, backend)
+
+	// gRPC-Web requests are served by a gRPC server of their own, since
+	// grpc.Server.GracefulStop cannot drain requests served through
+	// grpc.Server.ServeHTTP.
+	grpcWeb := grpc.NewServer(
+		grpc.StreamInterceptor(backend.StreamInterceptor),
+		grpc.UnaryInterceptor(backend.UnaryInterceptor),
+		grpc.StatsHandler(compression.StatsHandler{}),
+	)
+	registerServices(grpcWeb, config, backend)
+
+	var handler http.Handler = &grpcWebHandler{server: grpcWeb, next: router}
+	if len(config.corsOrigins) > 0 {
+		handler = newCORSHandler(config, router, grpcWeb, handler)
+	}
 	return &endpointREST{
-		server:   &http.Server{Handler: router},
+		server:   &http.Server{Handler: handler},
+		grpcWeb:  grpcWeb,
 		listener: lis,
 	}
 }
@@ -319,23 +702,34 @@ func (er *endpointREST) String() string {
 }
 
 func (er *endpointREST) Serve() error {
-	defer er.Shutdown()
-	if er.server != nil {
+	er.mux.Lock()
+	server := er.server
+	er.mux.Unlock()
+
+	if server != nil {
 		stdLog.Printf("Listening for REST connections")
-		return er.server.Serve(er.listener)
+		return server.Serve(er.listener)
 	}
 	return fmt.Errorf("REST server not set up")
 }
 
-func (er *endpointREST) Shutdown() error {
+func (er *endpointREST) Shutdown(ctx context.Context) error {
 	er.mux.Lock()
 	defer er.mux.Unlock()
 	var err error
 	if er.server != nil {
 		stdLog.Printf("Stopping REST connections")
-		err = er.server.Shutdown(context.Background())
+		err = er.server.Shutdown(ctx)
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			er.server.Close()
+		}
 		er.server = nil
 	}
+	if er.grpcWeb != nil {
+		// The gRPC-Web requests were drained along with the REST ones.
+		er.grpcWeb.Stop()
+		er.grpcWeb = nil
+	}
 	stdLog.Printf("Stopped REST")
 	return err
 }
@@ -0,0 +1,377 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testMux is an endpointMux serving the Echo service over gRPC and
+// handler over REST, as CreateAllEndpoints sets them up.
+type testMux struct {
+	*endpointMux
+	addr   string
+	served chan error // receives the result of Serve
+
+	// started receives the name of each unary call and REST request
+	// as its handling starts.
+	started chan string
+}
+
+func startTestMux(t *testing.T, handler http.Handler) *testMux {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := &testMux{
+		addr:    lis.Addr().String(),
+		served:  make(chan error, 1),
+		started: make(chan string, 10),
+	}
+
+	tracker := newTrackingListener(lis)
+	m := cmux.New(tracker)
+	m.SetReadTimeout(cmuxReadTimeout)
+	grpcListener := tracker.Matched(m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc")))
+	httpListener := tracker.Matched(m.Match(cmux.HTTP1Fast()))
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			tm.started <- info.FullMethod
+			return handler(ctx, req)
+		}))
+	pb.RegisterEchoServer(s, services.NewEchoServer())
+	rest := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tm.started <- r.URL.Path
+		handler.ServeHTTP(w, r)
+	})
+
+	tm.endpointMux = newEndpointMux(m, tracker,
+		&endpointGRPC{server: s, listener: grpcListener},
+		&endpointREST{server: &http.Server{Handler: rest}, listener: httpListener})
+	go func() { tm.served <- tm.Serve() }()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		tm.Shutdown(ctx)
+	})
+	return tm
+}
+
+func (tm *testMux) dial(t *testing.T) pb.EchoClient {
+	conn, err := grpc.Dial(tm.addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewEchoClient(conn)
+}
+
+// waitStarted waits until the handling of name has started.
+func (tm *testMux) waitStarted(t *testing.T, name string) {
+	t.Helper()
+	for {
+		select {
+		case started := <-tm.started:
+			if started == name {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s was not handled", name)
+		}
+	}
+}
+
+// block issues a Block call to client that takes delay to complete, and
+// returns a channel receiving its outcome.
+func block(client pb.EchoClient, delay time.Duration) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.Block(context.Background(), &pb.BlockRequest{
+			ResponseDelay: ptypes.DurationProto(delay),
+			Response:      &pb.BlockRequest_Success{Success: &pb.BlockResponse{Content: "done"}},
+		})
+		done <- err
+	}()
+	return done
+}
+
+// get issues a GET request for path to tm, and returns a channel
+// receiving the response body, or the error if the request failed.
+func (tm *testMux) get(path string) <-chan interface{} {
+	done := make(chan interface{}, 1)
+	go func() {
+		resp, err := http.Get("http://" + tm.addr + path)
+		if err != nil {
+			done <- err
+			return
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			done <- err
+			return
+		}
+		done <- string(body)
+	}()
+	return done
+}
+
+// startChat opens a Chat stream to client and waits until the server
+// has echoed a first message.
+func startChat(t *testing.T, client pb.EchoClient) pb.Echo_ChatClient {
+	t.Helper()
+	stream, err := client.Chat(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Chat: %v", err)
+	}
+	return stream
+}
+
+func TestEndpointMuxShutdown_drainsInFlightCalls(t *testing.T) {
+	release := make(chan struct{})
+	tm := startTestMux(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.Write([]byte("slow"))
+	}))
+	client := tm.dial(t)
+
+	stream := startChat(t, client)
+	blocked := block(client, 300*time.Millisecond)
+	tm.waitStarted(t, "/google.showcase.v1beta1.Echo/Block")
+	fetched := tm.get("/slow")
+	tm.waitStarted(t, "/slow")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- tm.Shutdown(ctx) }()
+
+	// The stream keeps working while the server drains.
+	if err := stream.Send(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "still there"}}); err != nil {
+		t.Fatalf("Chat after Shutdown: %v", err)
+	}
+	if resp, err := stream.Recv(); err != nil || resp.GetContent() != "still there" {
+		t.Fatalf("Chat after Shutdown = %v, %v, want the echoed message", resp, err)
+	}
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown returned %v before the in-flight calls completed", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err := <-blocked; err != nil {
+		t.Errorf("Block: %v", err)
+	}
+	close(release)
+	if body := <-fetched; body != "slow" {
+		t.Errorf("GET /slow = %v, want slow", body)
+	}
+	stream.CloseSend()
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Chat ended with %v, want io.EOF", err)
+	}
+
+	if err := <-shutdown; err != nil {
+		t.Errorf("Shutdown() = %v, want nil", err)
+	}
+	if err := <-tm.served; err != nil {
+		t.Errorf("Serve() = %v, want nil", err)
+	}
+}
+
+func TestEndpointMuxShutdown_forcesAfterGracePeriod(t *testing.T) {
+	tm := startTestMux(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	client := tm.dial(t)
+
+	stream := startChat(t, client)
+	blocked := block(client, time.Hour)
+	tm.waitStarted(t, "/google.showcase.v1beta1.Echo/Block")
+	fetched := tm.get("/hang")
+	tm.waitStarted(t, "/hang")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := tm.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Shutdown took %s after its grace period", elapsed)
+	}
+	if err := <-tm.served; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Serve() = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if err := <-blocked; status.Code(err) != codes.Unavailable && status.Code(err) != codes.Canceled {
+		t.Errorf("Block ended with %v, want it aborted", err)
+	}
+	if _, err := stream.Recv(); err == nil || err == io.EOF {
+		t.Errorf("Chat ended with %v, want it aborted", err)
+	}
+	if result := <-fetched; result == "" {
+		t.Errorf("GET /hang completed, want it aborted")
+	} else if _, ok := result.(error); !ok {
+		t.Errorf("GET /hang = %v, want it aborted", result)
+	}
+}
+
+func TestEndpointMuxShutdown_closesUnmatchedConnections(t *testing.T) {
+	tm := startTestMux(t, http.NotFoundHandler())
+
+	// A connection that has not sent enough for cmux to match it.
+	conn, err := net.Dial("tcp", tm.addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("PRI")); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tm.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() = %v, want nil", err)
+	}
+	if err := <-tm.served; err != nil {
+		t.Errorf("Serve() = %v, want nil", err)
+	}
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if n, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Errorf("Read() = %d, nil, want the connection closed", n)
+	} else if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		t.Errorf("the unmatched connection was not closed")
+	}
+}
+
+func TestWaitUntilDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := waitUntilDone(ctx, func() {}, nil); err != nil {
+		t.Errorf("waitUntilDone() = %v, want nil", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	forced, drained := make(chan struct{}), false
+	drain := func() {
+		<-forced
+		drained = true
+	}
+	if err := waitUntilDone(ctx, drain, func() { close(forced) }); err != context.DeadlineExceeded {
+		t.Errorf("waitUntilDone() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !drained {
+		t.Errorf("waitUntilDone() returned before the forced drain did")
+	}
+}
+
+func TestEndpointGRPCShutdown_forcesFallbackProxy(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := RuntimeConfig{fallbackPort: "localhost:0"}
+	eg := newEndpointGRPC(lis, config, createBackends(config), lis.Addr().String(), nil).(*endpointGRPC)
+	if eg.proxyListener == nil {
+		t.Fatal("the gRPC-fallback proxy was not set up")
+	}
+	served := make(chan error, 1)
+	go func() { served <- eg.Serve() }()
+
+	// A request in progress, which prevents the proxy from draining.
+	blocked := postFallback(eg.proxyListener.Addr().String(), "Block", &pb.BlockRequest{
+		ResponseDelay: ptypes.DurationProto(time.Hour),
+		Response:      &pb.BlockRequest_Success{Success: &pb.BlockResponse{Content: "done"}},
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := eg.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Shutdown took %s after its grace period", elapsed)
+	}
+	<-served
+	if result := <-blocked; result.err == nil && result.code == http.StatusOK {
+		t.Errorf("Block through the gRPC-fallback proxy completed, want it aborted")
+	}
+}
+
+func TestTrackingListener(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker := newTrackingListener(lis)
+	defer tracker.Close()
+
+	accept := func() net.Conn {
+		client, err := net.Dial("tcp", lis.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { client.Close() })
+		conn, err := tracker.Accept()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+	unmatched, matched, closed := accept(), accept(), accept()
+	tracker.forget(matched)
+	closed.Close()
+	if got := len(tracker.unmatched); got != 1 {
+		t.Errorf("%d connections tracked, want 1", got)
+	}
+
+	tracker.CloseUnmatched()
+	if _, err := unmatched.Write([]byte("x")); err == nil {
+		t.Errorf("the unmatched connection was not closed")
+	}
+	if _, err := matched.Write([]byte("x")); err != nil {
+		t.Errorf("the matched connection was closed: %v", err)
+	}
+	matched.Close()
+}
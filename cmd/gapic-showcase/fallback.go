@@ -0,0 +1,169 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	gmux "github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fallbackPath is the path of gRPC-fallback requests, which name the
+// fully-qualified service and the method to call.
+const fallbackPath = "/$rpc/{service:[.a-zA-Z0-9]+}/{method:[a-zA-Z]+}"
+
+// fallbackProxy serves gRPC-fallback requests, whose bodies are binary
+// protobuf messages sent over HTTP/1, by forwarding them to the gRPC
+// server. It follows github.com/googleapis/grpc-fallback-go/server,
+// whose FallbackServer listens on its own and so cannot be drained along
+// with the other endpoints.
+type fallbackProxy struct {
+	conn *grpc.ClientConn
+}
+
+// newFallbackProxy returns a proxy forwarding to the gRPC server at
+// backend, which is dialed without TLS since it is always local.
+func newFallbackProxy(backend string) (*fallbackProxy, error) {
+	conn, err := grpc.Dial(backend,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(fallbackCodec{})))
+	if err != nil {
+		return nil, err
+	}
+	return &fallbackProxy{conn: conn}, nil
+}
+
+// Handler returns the handler routing gRPC-fallback requests and their
+// CORS preflight requests.
+func (p *fallbackProxy) Handler() http.Handler {
+	router := gmux.NewRouter()
+	router.HandleFunc(fallbackPath, fallbackOptions).Methods(http.MethodOptions)
+	router.HandleFunc(fallbackPath, p.forward).Headers("Content-Type", "application/x-protobuf")
+	return router
+}
+
+// Close closes the connection to the gRPC server.
+func (p *fallbackProxy) Close() error {
+	return p.conn.Close()
+}
+
+// forward invokes the method named by r with the body of r, writing
+// the response message, or the status of the failed call, to w. The
+// call is canceled if the client goes away.
+func (p *fallbackProxy) forward(w http.ResponseWriter, r *http.Request) {
+	vars := gmux.Vars(r)
+	method := "/" + vars["service"] + "/" + vars["method"]
+
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	err := p.conn.Invoke(fallbackMetadata(r), method, r.Body, w)
+	if err == nil {
+		return
+	}
+	code, body := http.StatusInternalServerError, []byte(err.Error())
+	if st, ok := status.FromError(err); ok {
+		code = httpStatusFromCode(st.Code())
+		body, _ = proto.Marshal(st.Proto())
+	}
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// fallbackOptions answers the preflight request of CORS-enabled
+// gRPC-fallback calls.
+func fallbackOptions(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+	w.Header().Add("Access-Control-Allow-Headers", "*")
+	w.Header().Add("Access-Control-Allow-Methods", http.MethodPost)
+	w.Header().Add("Access-Control-Allow-Origin", "*")
+	w.Header().Add("Access-Control-Max-Age", "3600")
+	w.WriteHeader(http.StatusOK)
+}
+
+// fallbackMetadata returns the context of r with the Authorization and
+// x-goog-* headers of r as outgoing gRPC metadata.
+func fallbackMetadata(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		md.Set("authorization", auth)
+	}
+	for key, values := range r.Header {
+		if strings.HasPrefix(strings.ToLower(key), "x-goog-") {
+			md.Set(key, values...)
+		}
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// fallbackCodec passes the request body through to the gRPC server as
+// the encoded request message, and writes the encoded response message
+// as is to the HTTP response.
+type fallbackCodec struct{}
+
+func (fallbackCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, v.(io.Reader))
+	return buf.Bytes(), err
+}
+
+func (fallbackCodec) Unmarshal(data []byte, v interface{}) error {
+	_, err := v.(io.Writer).Write(data)
+	return err
+}
+
+func (fallbackCodec) Name() string {
+	return "fallback"
+}
+
+// httpStatusFromCode returns the HTTP status corresponding to a gRPC
+// status code, as listed in
+// https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// fallbackResult is the outcome of a gRPC-fallback request.
+type fallbackResult struct {
+	code int
+	body []byte
+	err  error
+}
+
+// postFallback calls the Echo method through the gRPC-fallback proxy at
+// address, and returns a channel receiving the result.
+func postFallback(address, method string, req proto.Message) <-chan fallbackResult {
+	done := make(chan fallbackResult, 1)
+	go func() {
+		b, err := proto.Marshal(req)
+		if err != nil {
+			done <- fallbackResult{err: err}
+			return
+		}
+		url := "http://" + address + "/$rpc/google.showcase.v1beta1.Echo/" + method
+		resp, err := http.Post(url, "application/x-protobuf", bytes.NewReader(b))
+		if err != nil {
+			done <- fallbackResult{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		done <- fallbackResult{code: resp.StatusCode, body: body, err: err}
+	}()
+	return done
+}
+
+// startFallbackProxy serves the Echo service over gRPC, and a
+// gRPC-fallback proxy forwarding to it, whose address is returned.
+func startFallbackProxy(t *testing.T) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterEchoServer(s, services.NewEchoServer())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	proxy, err := newFallbackProxy(lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { proxy.Close() })
+	server := httptest.NewServer(proxy.Handler())
+	t.Cleanup(server.Close)
+	return server.Listener.Addr().String()
+}
+
+func TestFallbackProxy(t *testing.T) {
+	address := startFallbackProxy(t)
+
+	result := <-postFallback(address, "Echo", &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}})
+	if result.err != nil || result.code != http.StatusOK {
+		t.Fatalf("Echo through the gRPC-fallback proxy = %d, %v, want 200", result.code, result.err)
+	}
+	resp := &pb.EchoResponse{}
+	if err := proto.Unmarshal(result.body, resp); err != nil || resp.GetContent() != "hello" {
+		t.Errorf("Echo through the gRPC-fallback proxy returned %v, %v, want %q", resp, err, "hello")
+	}
+
+	result = <-postFallback(address, "Echo", &pb.EchoRequest{Response: &pb.EchoRequest_Error{
+		Error: &spb.Status{Code: int32(codes.NotFound), Message: "missing"},
+	}})
+	if result.err != nil || result.code != http.StatusNotFound {
+		t.Fatalf("failing Echo through the gRPC-fallback proxy = %d, %v, want 404", result.code, result.err)
+	}
+	st := &spb.Status{}
+	if err := proto.Unmarshal(result.body, st); err != nil || st.GetCode() != int32(codes.NotFound) || st.GetMessage() != "missing" {
+		t.Errorf("failing Echo through the gRPC-fallback proxy returned %v, %v, want the NOT_FOUND status", st, err)
+	}
+}
+
+func TestFallbackProxy_preflight(t *testing.T) {
+	address := startFallbackProxy(t)
+	req, err := http.NewRequest(http.MethodOptions, "http://"+address+"/$rpc/google.showcase.v1beta1.Echo/Echo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Access-Control-Allow-Methods") != http.MethodPost {
+		t.Errorf("OPTIONS = %d with %v, want 200 allowing POST", resp.StatusCode, resp.Header)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// faultsSection is the schema of the `faults` section of the server
+// configuration file, giving the faults injected into every call to the
+// Showcase services. For example:
+//
+//	faults:
+//	  delay: 100ms
+//	  fail_rate: 0.1
+//	  status: UNAVAILABLE
+type faultsSection struct {
+	Delay    time.Duration `mapstructure:"delay"`
+	FailRate float64       `mapstructure:"fail_rate"`
+	Status   string        `mapstructure:"status"`
+}
+
+func init() {
+	registerConfigSection("faults", configSection{load: loadFaults, validate: validateFaults})
+}
+
+// loadFaults reads the faults section in v into config.
+func loadFaults(v *viper.Viper, _ *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &faultsSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	if v.IsSet("delay") {
+		config.faults.Delay = section.Delay
+	}
+	if v.IsSet("fail_rate") {
+		config.faults.FailRate = section.FailRate
+	}
+	if v.IsSet("status") {
+		code, err := server.ParseCode(section.Status)
+		if err != nil {
+			return fmt.Errorf("status: %v", err)
+		}
+		config.faults.Code = code
+	}
+	return nil
+}
+
+// validateFaults checks the faults to inject into every call.
+func validateFaults(config *RuntimeConfig) error {
+	if config.faults.Delay < 0 {
+		return fmt.Errorf("fault delay must not be negative: %s", config.faults.Delay)
+	}
+	if rate := config.faults.FailRate; rate < 0 || rate > 1 {
+		return fmt.Errorf("fault fail rate must be within [0, 1]: %v", rate)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"google.golang.org/grpc"
+)
+
+// The content types of gRPC-Web requests, as described in
+// https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-WEB.md. Either may
+// be followed by a subtype such as "+proto".
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+)
+
+// grpcWebTrailerFlag marks the frame carrying the trailers at the end of
+// a gRPC-Web response body.
+const grpcWebTrailerFlag = 0x80
+
+// grpcWebHandler serves gRPC-Web requests by translating them into
+// gRPC requests handled by server, which runs the same backend and
+// interceptors as the gRPC endpoint. All other requests are passed on to
+// next.
+//
+// Requests are sent over HTTP/1, so client streaming is not supported;
+// unary calls and server streaming are.
+type grpcWebHandler struct {
+	server *grpc.Server
+	next   http.Handler
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text, subtype, ok := parseGRPCWebContentType(contentType)
+	if !ok || r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	// grpc.Server.ServeHTTP only accepts HTTP/2 gRPC requests, which
+	// gRPC-Web requests are but for their framing.
+	req := r.Clone(server.WithTransport(r.Context(), server.TransportGRPCWeb))
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.Header.Set("Content-Type", "application/grpc"+subtype)
+	req.Header.Del("Content-Length")
+	if text {
+		req.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	gw := &grpcWebResponseWriter{
+		w:           w,
+		header:      http.Header{},
+		contentType: contentType,
+		text:        text,
+	}
+	h.server.ServeHTTP(gw, req)
+	gw.writeTrailers()
+}
+
+// parseGRPCWebContentType returns whether contentType is that of a
+// gRPC-Web request, whether its messages are base64-encoded, and its
+// subtype including the leading "+", if any.
+func parseGRPCWebContentType(contentType string) (text bool, subtype string, ok bool) {
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	for _, prefix := range []string{grpcWebTextContentType, grpcWebContentType} {
+		if !strings.HasPrefix(contentType, prefix) {
+			continue
+		}
+		subtype = contentType[len(prefix):]
+		if subtype != "" && subtype[0] != '+' {
+			return false, "", false
+		}
+		return prefix == grpcWebTextContentType, subtype, true
+	}
+	return false, "", false
+}
+
+// grpcWebResponseWriter turns the response that grpc.Server.ServeHTTP
+// writes for HTTP/2 into a gRPC-Web response, sending the trailers as
+// the last frame of the body.
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	contentType string
+	text        bool
+
+	wroteHeader bool
+	buf         bytes.Buffer // the data not yet flushed, in text mode
+}
+
+func (gw *grpcWebResponseWriter) Header() http.Header {
+	return gw.header
+}
+
+func (gw *grpcWebResponseWriter) WriteHeader(code int) {
+	if gw.wroteHeader {
+		return
+	}
+	gw.wroteHeader = true
+	h := gw.w.Header()
+	for k, vv := range gw.header {
+		if k == "Trailer" || strings.HasPrefix(k, http.TrailerPrefix) {
+			continue
+		}
+		h[k] = vv
+	}
+	h.Set("Content-Type", gw.contentType)
+	gw.w.WriteHeader(code)
+}
+
+// Write writes data to the body. In text mode, it is base64-encoded when
+// flushed, so that each flush sends whole frames.
+func (gw *grpcWebResponseWriter) Write(data []byte) (int, error) {
+	gw.WriteHeader(http.StatusOK)
+	if gw.text {
+		return gw.buf.Write(data)
+	}
+	return gw.w.Write(data)
+}
+
+func (gw *grpcWebResponseWriter) Flush() {
+	gw.WriteHeader(http.StatusOK)
+	if gw.text && gw.buf.Len() > 0 {
+		gw.w.Write([]byte(base64.StdEncoding.EncodeToString(gw.buf.Bytes())))
+		gw.buf.Reset()
+	}
+	if f, ok := gw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// writeTrailers writes the trailers set by grpc.Server.ServeHTTP, either
+// declared through the "Trailer" header or prefixed by
+// http.TrailerPrefix, as the last frame of the body.
+func (gw *grpcWebResponseWriter) writeTrailers() {
+	trailers := http.Header{}
+	for _, name := range gw.header["Trailer"] {
+		if vv, ok := gw.header[http.CanonicalHeaderKey(name)]; ok {
+			trailers[name] = vv
+		}
+	}
+	for k, vv := range gw.header {
+		if strings.HasPrefix(k, http.TrailerPrefix) {
+			trailers[strings.TrimPrefix(k, http.TrailerPrefix)] = vv
+		}
+	}
+
+	names := make([]string, 0, len(trailers))
+	for k := range trailers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	payload := &bytes.Buffer{}
+	for _, k := range names {
+		for _, v := range trailers[k] {
+			fmt.Fprintf(payload, "%s: %s\r\n", strings.ToLower(k), v)
+		}
+	}
+
+	frame := make([]byte, 5, 5+payload.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(payload.Len()))
+	gw.Write(append(frame, payload.Bytes()...))
+	gw.Flush()
+}
@@ -0,0 +1,248 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+func TestParseGRPCWebContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		text        bool
+		subtype     string
+		ok          bool
+	}{
+		{"application/grpc-web", false, "", true},
+		{"application/grpc-web+proto", false, "+proto", true},
+		{"application/grpc-web-text", true, "", true},
+		{"Application/GRPC-Web-Text+proto; charset=utf-8", true, "+proto", true},
+		{"application/grpc", false, "", false},
+		{"application/grpc-webby", false, "", false},
+		{"application/json", false, "", false},
+		{"", false, "", false},
+	}
+	for _, tt := range tests {
+		text, subtype, ok := parseGRPCWebContentType(tt.contentType)
+		if text != tt.text || subtype != tt.subtype || ok != tt.ok {
+			t.Errorf("parseGRPCWebContentType(%q) = %v, %q, %v, want %v, %q, %v",
+				tt.contentType, text, subtype, ok, tt.text, tt.subtype, tt.ok)
+		}
+	}
+}
+
+// transportObserver records the transport of the calls it observes.
+type transportObserver struct {
+	mu         sync.Mutex
+	transports []string
+}
+
+func (o *transportObserver) GetName() string { return "transportObserver" }
+
+func (o *transportObserver) ObserveUnary(ctx context.Context, _, _ interface{}, _ *grpc.UnaryServerInfo, _ error) {
+	call, _ := server.CallInfoFromContext(ctx)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.transports = append(o.transports, call.Transport)
+}
+
+// newGRPCWebTestServer returns an HTTP server translating gRPC-Web
+// requests for the Echo service, whose unary calls are observed by obs.
+func newGRPCWebTestServer(t *testing.T, obs server.UnaryObserver) *httptest.Server {
+	registry := server.ShowcaseObserverRegistry()
+	registry.RegisterUnaryObserver(obs)
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(registry.UnaryInterceptor),
+		grpc.StreamInterceptor(registry.StreamInterceptor))
+	pb.RegisterEchoServer(s, services.NewEchoServer())
+	ts := httptest.NewServer(&grpcWebHandler{server: s, next: http.NotFoundHandler()})
+	t.Cleanup(func() {
+		ts.Close()
+		s.Stop()
+	})
+	return ts
+}
+
+// grpcWebCall posts req to the given method of the Echo service as a
+// gRPC-Web request, and returns the response along with its decoded body.
+func grpcWebCall(t *testing.T, url, method string, req proto.Message, text bool) (*http.Response, []byte) {
+	b, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := make([]byte, 5, 5+len(b))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(b)))
+	body := append(frame, b...)
+	contentType := grpcWebContentType + "+proto"
+	if text {
+		body = []byte(base64.StdEncoding.EncodeToString(body))
+		contentType = grpcWebTextContentType + "+proto"
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, url+"/google.showcase.v1beta1.Echo/"+method, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("%s: %v", method, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("%s: reading the body: %v", method, err)
+	}
+	if !text {
+		return resp, respBody
+	}
+
+	// Each flush is encoded on its own, so the body may contain padding
+	// in the middle: it is decoded one quantum at a time.
+	if len(respBody)%4 != 0 {
+		t.Fatalf("%s: body %q is not made of base64 quanta", method, respBody)
+	}
+	var decoded []byte
+	for i := 0; i < len(respBody); i += 4 {
+		quantum, err := base64.StdEncoding.DecodeString(string(respBody[i : i+4]))
+		if err != nil {
+			t.Fatalf("%s: body %q is not base64: %v", method, respBody, err)
+		}
+		decoded = append(decoded, quantum...)
+	}
+	return resp, decoded
+}
+
+// grpcWebFrames splits a gRPC-Web response body into its messages and
+// the trailers of its last frame.
+func grpcWebFrames(t *testing.T, body []byte) ([][]byte, map[string]string) {
+	var messages [][]byte
+	for len(body) > 0 {
+		if len(body) < 5 {
+			t.Fatalf("truncated frame header: %q", body)
+		}
+		flag, size := body[0], binary.BigEndian.Uint32(body[1:5])
+		if len(body) < 5+int(size) {
+			t.Fatalf("truncated frame: %q", body)
+		}
+		payload := body[5 : 5+size]
+		body = body[5+size:]
+		if flag&grpcWebTrailerFlag == 0 {
+			messages = append(messages, payload)
+			continue
+		}
+		if len(body) > 0 {
+			t.Fatalf("frames after the trailers: %q", body)
+		}
+		trailers := map[string]string{}
+		for _, line := range strings.Split(strings.TrimSpace(string(payload)), "\r\n") {
+			if i := strings.Index(line, ": "); i >= 0 {
+				trailers[line[:i]] = line[i+2:]
+			}
+		}
+		return messages, trailers
+	}
+	t.Fatalf("no trailer frame")
+	return nil, nil
+}
+
+func TestGRPCWebHandler(t *testing.T) {
+	for _, text := range []bool{false, true} {
+		mode := map[bool]string{false: "binary", true: "text"}[text]
+		t.Run(mode, func(t *testing.T) {
+			obs := &transportObserver{}
+			ts := newGRPCWebTestServer(t, obs)
+
+			// Unary.
+			resp, body := grpcWebCall(t, ts.URL, "Echo", &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}, text)
+			if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc-web") {
+				t.Fatalf("Echo: got status %d and content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+			}
+			messages, trailers := grpcWebFrames(t, body)
+			echoed := &pb.EchoResponse{}
+			if len(messages) != 1 || proto.Unmarshal(messages[0], echoed) != nil || echoed.GetContent() != "hello" {
+				t.Errorf("Echo: got messages %q, want the echoed content", messages)
+			}
+			if trailers["grpc-status"] != "0" {
+				t.Errorf("Echo: got trailers %v, want grpc-status 0", trailers)
+			}
+
+			// Server streaming.
+			_, body = grpcWebCall(t, ts.URL, "Expand", &pb.ExpandRequest{Content: "a b c"}, text)
+			messages, trailers = grpcWebFrames(t, body)
+			var words []string
+			for _, m := range messages {
+				word := &pb.EchoResponse{}
+				if err := proto.Unmarshal(m, word); err != nil {
+					t.Fatalf("Expand: invalid message %q: %v", m, err)
+				}
+				words = append(words, word.GetContent())
+			}
+			if strings.Join(words, " ") != "a b c" || trailers["grpc-status"] != "0" {
+				t.Errorf("Expand: got %q and trailers %v, want the expanded words and grpc-status 0", words, trailers)
+			}
+
+			// An error status, sent without any message.
+			failed := &pb.EchoRequest{Response: &pb.EchoRequest_Error{Error: &spb.Status{Code: 5, Message: "not here"}}}
+			resp, body = grpcWebCall(t, ts.URL, "Echo", failed, text)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Echo: got status %d, want the error in the trailers", resp.StatusCode)
+			}
+			messages, trailers = grpcWebFrames(t, body)
+			if len(messages) != 0 || trailers["grpc-status"] != "5" || trailers["grpc-message"] != "not here" {
+				t.Errorf("Echo: got messages %q and trailers %v, want grpc-status 5 alone", messages, trailers)
+			}
+
+			obs.mu.Lock()
+			defer obs.mu.Unlock()
+			if len(obs.transports) != 2 {
+				t.Errorf("got %d observed unary calls, want 2", len(obs.transports))
+			}
+			for _, transport := range obs.transports {
+				if transport != server.TransportGRPCWeb {
+					t.Errorf("CallInfo.Transport = %q, want %q", transport, server.TransportGRPCWeb)
+				}
+			}
+		})
+	}
+}
+
+func TestGRPCWebHandler_notGRPCWeb(t *testing.T) {
+	ts := newGRPCWebTestServer(t, &transportObserver{})
+	resp, err := http.Post(ts.URL+"/v1beta1/echo:echo", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status %d, want the request passed on to the next handler", resp.StatusCode)
+	}
+}
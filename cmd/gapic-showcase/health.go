@@ -0,0 +1,251 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	gmux "github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// serviceFullNames maps the names in showcaseServices to the fully
+// qualified names under which the health service reports them.
+var serviceFullNames = map[string]string{
+	"Echo":            "google.showcase.v1beta1.Echo",
+	"Identity":        "google.showcase.v1beta1.Identity",
+	"Messaging":       "google.showcase.v1beta1.Messaging",
+	"SequenceService": "google.showcase.v1beta1.SequenceService",
+	"Testing":         "google.showcase.v1beta1.Testing",
+	"Operations":      "google.longrunning.Operations",
+	"Admin":           "google.showcase.v1beta1.Admin",
+}
+
+// newHealthServer returns a grpc.health.v1.Health server reporting
+// every enabled service, as well as the server as a whole, as SERVING.
+func newHealthServer(config RuntimeConfig) *health.Server {
+	healthServer := health.NewServer()
+	for _, name := range showcaseServices {
+		if config.serviceEnabled(name) {
+			healthServer.SetServingStatus(serviceFullNames[name], healthpb.HealthCheckResponse_SERVING)
+		}
+	}
+	return healthServer
+}
+
+// healthCheckedService resolves name, given either as in
+// showcaseServices or fully qualified, to the name under which the
+// health service reports it.
+func healthCheckedService(name string) (string, error) {
+	if service := canonicalServiceName(name); service != "" {
+		return serviceFullNames[service], nil
+	}
+	for _, fullName := range serviceFullNames {
+		if fullName == name {
+			return fullName, nil
+		}
+	}
+	return "", fmt.Errorf("unknown service %q: must be one of %s", name, strings.Join(showcaseServices, ", "))
+}
+
+// registerHealthHandlers adds HTTP handlers reporting the state of
+// healthServer to router:
+//
+//	GET /healthz    succeeds as long as the server is running
+//	GET /readyz     succeeds only if every service is SERVING
+//
+// The statuses are changed through the Admin service.
+func registerHealthHandlers(router *gmux.Router, healthServer *health.Server) {
+	router.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("ok\n"))
+	}).Methods(http.MethodGet)
+
+	router.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		services := []string{""}
+		for _, fullName := range serviceFullNames {
+			services = append(services, fullName)
+		}
+		sort.Strings(services)
+
+		ready := true
+		report := []string{}
+		for _, service := range services {
+			resp, err := healthServer.Check(r.Context(), &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				// The service is not registered.
+				continue
+			}
+			if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+				ready = false
+			}
+			if service == "" {
+				service = "server"
+			}
+			report = append(report, fmt.Sprintf("%s: %s", service, resp.GetStatus()))
+		}
+
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		w.Write([]byte(strings.Join(report, "\n") + "\n"))
+	}).Methods(http.MethodGet)
+}
+
+// healthConnection holds the flags of the health commands telling how to
+// reach the showcase server, named as those of the generated client
+// commands.
+type healthConnection struct {
+	address  string
+	insecure bool
+	caCert   string
+	cert     string
+	key      string
+}
+
+// dial connects to the showcase server, over TLS unless c.insecure is set.
+func (c *healthConnection) dial() (*grpc.ClientConn, error) {
+	creds := grpc.WithInsecure()
+	if !c.insecure {
+		config, err := clientTLSConfig(c.caCert, c.cert, c.key)
+		if err != nil {
+			return nil, err
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(config))
+	}
+	return grpc.Dial(c.address, creds)
+}
+
+// checkHealth returns the serving status reported by the server on conn
+// for service, given as for healthCheckedService, or for the whole
+// server if service is empty.
+func checkHealth(ctx context.Context, conn *grpc.ClientConn, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	if service != "" {
+		var err error
+		if service, err = healthCheckedService(service); err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN, err
+		}
+	}
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	return resp.GetStatus(), nil
+}
+
+// setServingStatus sets the serving status reported by the server on
+// conn for service, given as for healthCheckedService, through the Admin
+// service. It returns the fully qualified name of the service.
+func setServingStatus(ctx context.Context, conn *grpc.ClientConn, service, statusName string) (string, error) {
+	service, err := healthCheckedService(service)
+	if err != nil {
+		return "", err
+	}
+	status := pb.SetServingStatusRequest_ServingStatus(pb.SetServingStatusRequest_ServingStatus_value[statusName])
+	if status == pb.SetServingStatusRequest_SERVING_STATUS_UNSPECIFIED {
+		return "", fmt.Errorf("invalid status %q: must be SERVING or NOT_SERVING", statusName)
+	}
+	_, err = pb.NewAdminClient(conn).SetServingStatus(ctx, &pb.SetServingStatusRequest{Service: service, Status: status})
+	return service, err
+}
+
+func init() {
+	connection := &healthConnection{}
+	healthCmd := &cobra.Command{
+		Use:   "health",
+		Short: "Checks and controls the health status reported by the showcase server",
+	}
+	healthCmd.PersistentFlags().StringVar(
+		&connection.address,
+		"address",
+		"localhost:7469",
+		"The address of the showcase server, or unix:///path/to/socket for a Unix domain socket.")
+	healthCmd.PersistentFlags().BoolVar(
+		&connection.insecure,
+		"insecure",
+		false,
+		"Make insecure client connection.")
+	healthCmd.PersistentFlags().StringVar(
+		&connection.caCert,
+		"ca_cert",
+		"",
+		"The path to the CA certificate to verify the server certificate with, such as the ca.pem written by --generate-self-signed. The system roots are used if not set.")
+	healthCmd.PersistentFlags().StringVar(
+		&connection.cert,
+		"cert",
+		"",
+		"The path to the client certificate to present to a server requiring one, such as the client.pem written by --generate-self-signed.")
+	healthCmd.PersistentFlags().StringVar(
+		&connection.key,
+		"key",
+		"",
+		"The path to the private key of --cert.")
+
+	checkCmd := &cobra.Command{
+		Use:   "check [SERVICE]",
+		Short: "Reports the serving status of a service, or of the whole server if no service is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			service := ""
+			if len(args) > 0 {
+				service = args[0]
+			}
+			conn, err := connection.dial()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			status, err := checkHealth(context.Background(), conn, service)
+			if err != nil {
+				return err
+			}
+			fmt.Println(status)
+			return nil
+		},
+	}
+
+	setStatusCmd := &cobra.Command{
+		Use:   "set-status SERVICE STATUS",
+		Short: "Sets the serving status (SERVING or NOT_SERVING) reported for a service",
+		Long: "Sets the serving status (SERVING or NOT_SERVING) reported for a service by the " +
+			"grpc.health.v1.Health service and /readyz, through the Admin service. This is " +
+			"useful to test health-aware client-side load balancing.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, err := connection.dial()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			service, err := setServingStatus(context.Background(), conn, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: %s\n", service, args[1])
+			return nil
+		},
+	}
+
+	healthCmd.AddCommand(checkCmd, setStatusCmd)
+	rootCmd.AddCommand(healthCmd)
+}
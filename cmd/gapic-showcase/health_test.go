@@ -0,0 +1,278 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/genrest"
+	"github.com/googleapis/gapic-showcase/server/services"
+	gmux "github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const echoFullName = "google.showcase.v1beta1.Echo"
+
+// newHealthBackend returns a backend reporting every service as SERVING,
+// whose Admin service sets the reported statuses.
+func newHealthBackend() *services.Backend {
+	discard := log.New(ioutil.Discard, "", 0)
+	backend := &services.Backend{
+		HealthServer: newHealthServer(RuntimeConfig{}),
+		StdLog:       discard,
+		ErrLog:       discard,
+	}
+	backend.AdminServer = services.NewAdminServer(backend)
+	return backend
+}
+
+// startHealthREST serves the health handlers and the REST bindings of
+// backend, as newEndpointREST does.
+func startHealthREST(t *testing.T, backend *services.Backend) *httptest.Server {
+	router := gmux.NewRouter()
+	registerHealthHandlers(router, backend.HealthServer)
+	genrest.RegisterHandlers(router, backend)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// httpDo issues a request to server and returns the status code and body
+// of the response.
+func httpDo(t *testing.T, server *httptest.Server, method, path, body string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestHealthHandlers_healthz(t *testing.T) {
+	server := startHealthREST(t, newHealthBackend())
+	if code, body := httpDo(t, server, http.MethodGet, "/healthz", ""); code != http.StatusOK || body != "ok\n" {
+		t.Errorf("GET /healthz = %d %q, want 200 \"ok\"", code, body)
+	}
+}
+
+func TestHealthHandlers_readyz(t *testing.T) {
+	backend := newHealthBackend()
+	server := startHealthREST(t, backend)
+
+	code, body := httpDo(t, server, http.MethodGet, "/readyz", "")
+	if code != http.StatusOK || !strings.Contains(body, "server: SERVING") || !strings.Contains(body, echoFullName+": SERVING") {
+		t.Errorf("GET /readyz with every service SERVING = %d %q, want 200 listing them", code, body)
+	}
+
+	backend.HealthServer.SetServingStatus(echoFullName, healthpb.HealthCheckResponse_NOT_SERVING)
+	code, body = httpDo(t, server, http.MethodGet, "/readyz", "")
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, echoFullName+": NOT_SERVING") {
+		t.Errorf("GET /readyz with Echo NOT_SERVING = %d %q, want 503 listing it", code, body)
+	}
+}
+
+func TestHealthHandlers_setServingStatus(t *testing.T) {
+	backend := newHealthBackend()
+	server := startHealthREST(t, backend)
+	const path = "/v1beta1/admin/health:setServingStatus"
+
+	if code, body := httpDo(t, server, http.MethodPost, "/readyz/Echo?status=NOT_SERVING", ""); code == http.StatusOK {
+		t.Errorf("POST /readyz/Echo = %d %q, want the route to be gone", code, body)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"invalid status", `{"service": "` + echoFullName + `", "status": "SERVING_STATUS_UNSPECIFIED"}`, http.StatusBadRequest},
+		{"unknown service", `{"service": "google.showcase.v1beta1.Unknown", "status": "NOT_SERVING"}`, http.StatusNotFound},
+		{"valid status", `{"service": "` + echoFullName + `", "status": "NOT_SERVING"}`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		if code, body := httpDo(t, server, http.MethodPost, path, tt.body); code != tt.want {
+			t.Errorf("POST %s with %s = %d %q, want %d", path, tt.name, code, body, tt.want)
+		}
+	}
+
+	if code, body := httpDo(t, server, http.MethodGet, "/readyz", ""); code != http.StatusServiceUnavailable || !strings.Contains(body, echoFullName+": NOT_SERVING") {
+		t.Errorf("GET /readyz after setting Echo NOT_SERVING = %d %q, want 503 listing it", code, body)
+	}
+}
+
+// startHealthGRPC serves the health and Admin services of backend over
+// gRPC with TLS, requiring client certificates signed by the CA in dir,
+// and returns the address it listens on.
+func startHealthGRPC(t *testing.T, backend *services.Backend, dir string) string {
+	config := RuntimeConfig{
+		tlsCert:   filepath.Join(dir, "server.pem"),
+		tlsKey:    filepath.Join(dir, "server.key"),
+		tlsCaCert: filepath.Join(dir, "ca.pem"),
+	}
+	tlsConfig, err := config.serverTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	healthpb.RegisterHealthServer(s, backend.HealthServer)
+	pb.RegisterAdminServer(s, backend.AdminServer)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+	return "localhost:" + port
+}
+
+func TestHealthCommands(t *testing.T) {
+	dir := t.TempDir()
+	if err := generateSelfSigned(dir); err != nil {
+		t.Fatal(err)
+	}
+	address := startHealthGRPC(t, newHealthBackend(), dir)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	insecure, err := (&healthConnection{address: address, insecure: true}).dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer insecure.Close()
+	if _, err := checkHealth(ctx, insecure, ""); err == nil {
+		t.Error("checkHealth over an insecure connection to a TLS server succeeded")
+	}
+
+	conn, err := (&healthConnection{
+		address: address,
+		caCert:  filepath.Join(dir, "ca.pem"),
+		cert:    filepath.Join(dir, "client.pem"),
+		key:     filepath.Join(dir, "client.key"),
+	}).dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if status, err := checkHealth(ctx, conn, "Echo"); err != nil || status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("checkHealth(Echo) = %v, %v, want SERVING", status, err)
+	}
+
+	service, err := setServingStatus(ctx, conn, "Echo", "NOT_SERVING")
+	if err != nil || service != echoFullName {
+		t.Fatalf("setServingStatus(Echo, NOT_SERVING) = %q, %v, want %q", service, err, echoFullName)
+	}
+	if status, err := checkHealth(ctx, conn, echoFullName); err != nil || status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("checkHealth(Echo) after setServingStatus = %v, %v, want NOT_SERVING", status, err)
+	}
+	if status, err := checkHealth(ctx, conn, ""); err != nil || status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("checkHealth() after setServingStatus = %v, %v, want the server still SERVING", status, err)
+	}
+
+	if _, err := setServingStatus(ctx, conn, "Echo", "SERVICE_UNKNOWN"); err == nil {
+		t.Error("setServingStatus with an invalid status succeeded")
+	}
+	if _, err := setServingStatus(ctx, conn, "Unknown", "SERVING"); err == nil {
+		t.Error("setServingStatus with an unknown service succeeded")
+	}
+}
+
+func TestEndpointGRPC_shutdownReportsNotServing(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{}, 1)
+	s := grpc.NewServer(grpc.UnaryInterceptor(
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			started <- struct{}{}
+			return handler(ctx, req)
+		}))
+	pb.RegisterEchoServer(s, services.NewEchoServer())
+	healthServer := newHealthServer(RuntimeConfig{})
+	eg := &endpointGRPC{server: s, health: healthServer, listener: lis}
+	go eg.Serve()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	done := block(pb.NewEchoClient(conn), time.Second)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Block was not handled")
+	}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdown <- eg.Shutdown(ctx)
+	}()
+
+	router := gmux.NewRouter()
+	registerHealthHandlers(router, healthServer)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: echoFullName})
+		if err == nil && resp.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Check(Echo) while shutting down = %v, %v, want NOT_SERVING", resp, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("Block completed with %v before NOT_SERVING was observed; want it still draining", err)
+	default:
+	}
+	if code, body := httpDo(t, server, http.MethodGet, "/readyz", ""); code != http.StatusServiceUnavailable || !strings.Contains(body, "server: NOT_SERVING") {
+		t.Errorf("GET /readyz while shutting down = %d %q, want 503", code, body)
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("in-flight Block: %v", err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Errorf("Shutdown: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// limitsSection is the schema of the `limits` section of the server
+// configuration file, bounding the requests to the Showcase services.
+// For example:
+//
+//	limits:
+//	  max_page_size: 50
+type limitsSection struct {
+	MaxPageSize int32 `mapstructure:"max_page_size"`
+}
+
+func init() {
+	registerConfigSection("limits", configSection{load: loadLimits, validate: validateLimits})
+}
+
+// loadLimits reads the limits section in v into config.
+func loadLimits(v *viper.Viper, _ *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &limitsSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	if v.IsSet("max_page_size") {
+		config.maxPageSize = section.MaxPageSize
+	}
+	return nil
+}
+
+// validateLimits checks the limits on the requests.
+func validateLimits(config *RuntimeConfig) error {
+	if config.maxPageSize < 0 {
+		return fmt.Errorf("maximum page size must not be negative: %d", config.maxPageSize)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/soheilhy/cmux"
+)
+
+// trackingListener is a net.Listener that keeps track of the
+// connections it has accepted but which cmux has not yet handed to
+// any endpoint. cmux blocks while sniffing such connections (e.g. an
+// HTTP/2 client that has sent its preface but no request yet), and
+// its Serve does not return until they are matched or closed, which
+// would in turn prevent the endpoints from shutting down. A listener
+// that is not multiplexed tracks all its connections until they are
+// closed, so that they can be dropped when draining them takes too long.
+type trackingListener struct {
+	net.Listener
+
+	mu        sync.Mutex
+	unmatched map[net.Conn]struct{}
+	closeOnce sync.Once
+}
+
+func newTrackingListener(lis net.Listener) *trackingListener {
+	return &trackingListener{
+		Listener:  lis,
+		unmatched: map[net.Conn]struct{}{},
+	}
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	tracked := &trackedConn{Conn: conn, root: l}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.unmatched[tracked] = struct{}{}
+	return tracked, nil
+}
+
+// Close closes the underlying listener. Every endpoint closes the
+// listener it is given by cmux, which closes this shared one in turn, so
+// only the first call does so and reports its error.
+func (l *trackingListener) Close() error {
+	var err error
+	l.closeOnce.Do(func() { err = l.Listener.Close() })
+	return err
+}
+
+// Matched returns a net.Listener wrapping lis, a listener returned by
+// cmux, that marks the connections it accepts as no longer unmatched.
+func (l *trackingListener) Matched(lis net.Listener) net.Listener {
+	return &matchedListener{Listener: lis, root: l}
+}
+
+// CloseUnmatched closes all the accepted connections that have not
+// been handed to an endpoint.
+func (l *trackingListener) CloseUnmatched() {
+	l.mu.Lock()
+	unmatched := l.unmatched
+	l.unmatched = map[net.Conn]struct{}{}
+	l.mu.Unlock()
+
+	for conn := range unmatched {
+		conn.Close()
+	}
+}
+
+func (l *trackingListener) forget(conn net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.unmatched, conn)
+}
+
+// trackedConn is a connection accepted by a trackingListener. It stops
+// being tracked once it is closed, whether or not it was matched.
+type trackedConn struct {
+	net.Conn
+	root *trackingListener
+}
+
+func (c *trackedConn) Close() error {
+	c.root.forget(c)
+	return c.Conn.Close()
+}
+
+// matchedListener is the endpoint-facing side of a trackingListener.
+type matchedListener struct {
+	net.Listener
+	root *trackingListener
+}
+
+func (l *matchedListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if muxConn, ok := conn.(*cmux.MuxConn); ok {
+		l.root.forget(muxConn.Conn)
+	}
+	return conn, nil
+}
+
+// parseListenAddress returns the network and address to listen on for an
+// address given to --listen: "unix://PATH" or "unix:PATH" for a Unix
+// domain socket, or "tcp://HOST:PORT", "HOST:PORT", ":PORT" or "PORT"
+// for TCP.
+func parseListenAddress(address string) (network, addr string, err error) {
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		network, addr = "unix", strings.TrimPrefix(address, "unix://")
+	case strings.HasPrefix(address, "unix:"):
+		network, addr = "unix", strings.TrimPrefix(address, "unix:")
+	default:
+		network, addr = "tcp", strings.TrimPrefix(address, "tcp://")
+		if !strings.Contains(addr, ":") {
+			addr = ":" + addr
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return "", "", fmt.Errorf("invalid listen address %q: %v", address, err)
+		}
+	}
+	if addr == "" || addr == ":" {
+		return "", "", fmt.Errorf("invalid listen address %q: missing %s address", address, network)
+	}
+	return network, addr, nil
+}
+
+// listen opens a listener on each of addresses, as given to --listen,
+// and returns a listener accepting the connections of all of them.
+// Stale Unix domain sockets left behind by a previous server are
+// removed first, but sockets that still accept connections are not.
+func listen(addresses []string) (net.Listener, error) {
+	listeners := []net.Listener{}
+	closeAll := func() {
+		for _, lis := range listeners {
+			lis.Close()
+		}
+	}
+	for _, address := range addresses {
+		network, addr, err := parseListenAddress(address)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		if network == "unix" {
+			if err := removeStaleSocket(addr); err != nil {
+				closeAll()
+				return nil, err
+			}
+		}
+		lis, err := net.Listen(network, addr)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		listeners = append(listeners, lis)
+	}
+	if len(listeners) == 1 {
+		return listeners[0], nil
+	}
+	return newMultiListener(listeners), nil
+}
+
+// removeStaleSocket removes the Unix domain socket at path, if any,
+// unless some server still accepts connections on it.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode()&os.ModeSocket == 0 {
+		return nil
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("cannot listen on %s: another server is listening on it", path)
+	}
+	return os.Remove(path)
+}
+
+// errListenerClosed is returned by multiListener.Accept once the
+// listener is closed.
+var errListenerClosed = errors.New("use of closed network connection")
+
+// multiListener is a net.Listener accepting the connections of several
+// listeners, so that cmux can serve them all.
+type multiListener struct {
+	listeners []net.Listener
+	accepted  chan acceptResult
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+type acceptResult struct {
+	conn net.Conn
+	err  error
+}
+
+func newMultiListener(listeners []net.Listener) *multiListener {
+	ml := &multiListener{
+		listeners: listeners,
+		accepted:  make(chan acceptResult),
+		done:      make(chan struct{}),
+	}
+	for _, lis := range listeners {
+		go ml.acceptFrom(lis)
+	}
+	return ml
+}
+
+// acceptFrom hands the connections accepted by lis to Accept until lis
+// fails with a permanent error.
+func (ml *multiListener) acceptFrom(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		select {
+		case ml.accepted <- acceptResult{conn, err}:
+		case <-ml.done:
+			if conn != nil {
+				conn.Close()
+			}
+			return
+		}
+		if ne, ok := err.(net.Error); err != nil && (!ok || !ne.Temporary()) {
+			return
+		}
+	}
+}
+
+func (ml *multiListener) Accept() (net.Conn, error) {
+	select {
+	case result := <-ml.accepted:
+		return result.conn, result.err
+	case <-ml.done:
+		return nil, errListenerClosed
+	}
+}
+
+// Close closes all the listeners.
+func (ml *multiListener) Close() error {
+	var err error
+	ml.closeOnce.Do(func() {
+		close(ml.done)
+		for _, lis := range ml.listeners {
+			if closeErr := lis.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+// Addr returns the address of the first listener.
+func (ml *multiListener) Addr() net.Addr {
+	return ml.listeners[0].Addr()
+}
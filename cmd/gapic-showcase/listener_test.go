@@ -0,0 +1,242 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestParseListenAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		network string
+		addr    string
+		wantErr bool
+	}{
+		{address: "7469", network: "tcp", addr: ":7469"},
+		{address: ":7469", network: "tcp", addr: ":7469"},
+		{address: "localhost:7469", network: "tcp", addr: "localhost:7469"},
+		{address: "tcp://127.0.0.1:7469", network: "tcp", addr: "127.0.0.1:7469"},
+		{address: "[::1]:7469", network: "tcp", addr: "[::1]:7469"},
+		{address: "unix:///tmp/showcase.sock", network: "unix", addr: "/tmp/showcase.sock"},
+		{address: "unix://showcase.sock", network: "unix", addr: "showcase.sock"},
+		{address: "unix:showcase.sock", network: "unix", addr: "showcase.sock"},
+		{address: "", wantErr: true},
+		{address: "tcp://", wantErr: true},
+		{address: "unix://", wantErr: true},
+		{address: "unix:", wantErr: true},
+		{address: "localhost:7469:1", wantErr: true},
+	}
+	for _, tt := range tests {
+		network, addr, err := parseListenAddress(tt.address)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseListenAddress(%q) = %q, %q, want an error", tt.address, network, addr)
+			}
+			continue
+		}
+		if err != nil || network != tt.network || addr != tt.addr {
+			t.Errorf("parseListenAddress(%q) = %q, %q, %v, want %q, %q", tt.address, network, addr, err, tt.network, tt.addr)
+		}
+	}
+}
+
+func TestListen_unixSockets(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "stale.sock")
+	live := filepath.Join(dir, "live.sock")
+
+	// A socket whose server is gone, as left behind by a crash.
+	staleLis, err := net.Listen("unix", stale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staleLis.(*net.UnixListener).SetUnlinkOnClose(false)
+	staleLis.Close()
+	if _, err := os.Lstat(stale); err != nil {
+		t.Fatalf("the stale socket was removed: %v", err)
+	}
+
+	lis, err := listen([]string{"unix://" + stale})
+	if err != nil {
+		t.Fatalf("listen on a stale socket: %v", err)
+	}
+	lis.Close()
+
+	// A socket another server still listens on.
+	liveLis, err := net.Listen("unix", live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer liveLis.Close()
+	go func() {
+		for {
+			conn, err := liveLis.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	if lis, err := listen([]string{"unix://" + live}); err == nil {
+		lis.Close()
+		t.Fatalf("listen on a live socket succeeded, want an error")
+	}
+	if conn, err := net.Dial("unix", live); err != nil {
+		t.Errorf("the live socket no longer accepts connections: %v", err)
+	} else {
+		conn.Close()
+	}
+
+	// A regular file is never removed.
+	file := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(file, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if lis, err := listen([]string{"unix:" + file}); err == nil {
+		lis.Close()
+		t.Errorf("listen on a regular file succeeded, want an error")
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("the regular file was removed: %v", err)
+	}
+}
+
+func TestListen_closesOnError(t *testing.T) {
+	lis, err := listen([]string{"localhost:0", "localhost:7469:1"})
+	if err == nil {
+		lis.Close()
+		t.Fatalf("listen succeeded, want an error for the invalid address")
+	}
+}
+
+func TestMultiListener(t *testing.T) {
+	var listeners []net.Listener
+	for i := 0; i < 2; i++ {
+		lis, err := net.Listen("tcp", "localhost:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		listeners = append(listeners, lis)
+	}
+	ml := newMultiListener(listeners)
+	if ml.Addr() != listeners[0].Addr() {
+		t.Errorf("Addr() = %v, want the address of the first listener %v", ml.Addr(), listeners[0].Addr())
+	}
+
+	// Connections to either listener are accepted.
+	for _, lis := range listeners {
+		client, err := net.Dial("tcp", lis.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		conn, err := ml.Accept()
+		if err != nil {
+			t.Fatalf("Accept: %v", err)
+		}
+		if conn.LocalAddr().String() != lis.Addr().String() {
+			t.Errorf("accepted a connection to %v, want one to %v", conn.LocalAddr(), lis.Addr())
+		}
+		conn.Close()
+	}
+
+	// Closing it closes all the listeners, and unblocks Accept.
+	accepted := make(chan error)
+	go func() {
+		_, err := ml.Accept()
+		accepted <- err
+	}()
+	if err := ml.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	select {
+	case err := <-accepted:
+		if err != errListenerClosed {
+			t.Errorf("Accept after Close returned %v, want %v", err, errListenerClosed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Accept still blocked after Close")
+	}
+	for _, lis := range listeners {
+		if conn, err := net.Dial("tcp", lis.Addr().String()); err == nil {
+			conn.Close()
+			t.Errorf("%v still accepts connections after Close", lis.Addr())
+		}
+	}
+	if err := ml.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+func TestFallbackTarget(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		addresses []string
+		want      string
+	}{
+		{[]string{"7469"}, "localhost:7469"},
+		{[]string{"0.0.0.0:7469"}, "localhost:7469"},
+		{[]string{"unix:///tmp/showcase.sock", "tcp://[::1]:7469"}, "localhost:7469"},
+		{[]string{"unix:///tmp/showcase.sock"}, "unix:///tmp/showcase.sock"},
+		{[]string{"unix:showcase.sock", "unix:///tmp/other.sock"}, "unix://" + filepath.Join(wd, "showcase.sock")},
+		{[]string{"localhost:7469:1"}, ""},
+	}
+	for _, tt := range tests {
+		if got := fallbackTarget(tt.addresses); got != tt.want {
+			t.Errorf("fallbackTarget(%q) = %q, want %q", tt.addresses, got, tt.want)
+		}
+	}
+}
+
+// TestFallbackTarget_unixSocket checks that gRPC dials the target of a
+// Unix domain socket.
+func TestFallbackTarget_unixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "showcase.sock")
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(lis)
+	defer s.Stop()
+
+	target := fallbackTarget([]string{"unix://" + path})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, target, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatalf("dialing %q: %v", target, err)
+	}
+	defer conn.Close()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("health check through %q: got %v, %v", target, resp, err)
+	}
+}
@@ -15,10 +15,18 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/googleapis/gapic-showcase/server/compression"
 	"github.com/spf13/cobra"
 )
 
@@ -29,38 +37,110 @@ func message(err error) string {
 	return err.Error()
 }
 
+// applyLogLevel adjusts the server logs to the given level: "debug"
+// additionally logs request headers (as --verbose does), and "error"
+// suppresses everything but errors.
+func applyLogLevel(level string) {
+	switch level {
+	case "debug":
+		Verbose = true
+	case "error":
+		stdLog.SetOutput(ioutil.Discard)
+	}
+}
+
 func init() {
 	config := RuntimeConfig{}
+	configFilePath := ""
+	seedFilePath := ""
 	runCmd := &cobra.Command{
 		Use:   "run",
 		Short: "Runs the showcase server",
 		Run: func(cmd *cobra.Command, args []string) {
-			cmuxServer := CreateAllEndpoints(config)
+			if configFilePath != "" {
+				if err := loadConfigFile(configFilePath, cmd.Flags(), &config); err != nil {
+					log.Fatalf("Showcase failed to load configuration: %v", err)
+				}
+			}
+			if seedFilePath != "" {
+				seed, err := loadSeedFile(seedFilePath)
+				if err != nil {
+					log.Fatalf("Showcase failed to load seed data: %v", err)
+				}
+				config.seed = seed
+			}
+			if err := config.validate(); err != nil {
+				log.Fatalf("Showcase configuration is invalid: %v", err)
+			}
+			applyLogLevel(config.logLevel)
+			if dir := config.selfSignedDir; dir != "" {
+				if err := generateSelfSigned(dir); err != nil {
+					log.Fatalf("Showcase failed to generate certificates: %v", err)
+				}
+				config.tlsCert, config.tlsKey = filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key")
+				stdLog.Printf("Showcase wrote a throwaway CA (ca.pem) and server and client certificates to %s", dir)
+			}
 
-			done := make(chan os.Signal, 2)
-			signal.Notify(done, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGSTOP)
+			endpoint := CreateAllEndpoints(config)
+
+			signals := make(chan os.Signal, 2)
+			signal.Notify(signals, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 			go func() {
-				sig := <-done
-				stdLog.Printf("Got signal %q", sig)
-				stdLog.Printf("Shutting down server: %s", message(cmuxServer.Shutdown()))
+				sig := <-signals
+				stdLog.Printf("Got signal %q; draining connections for up to %s", sig, config.shutdownTimeout)
 
-				// TODO: Delete the following line once this PR is
-				// merged: https://github.com/soheilhy/cmux/pull/69. The issue is
-				// that the user cannot Ctrl-C the main binary, probably due to
-				// https://github.com/soheilhy/cmux/pull/69#issuecomment-712928041.
-				os.Exit(1)
+				// A second signal skips the remainder of the grace period.
+				ctx, cancel := context.WithTimeout(context.Background(), config.shutdownTimeout)
+				defer cancel()
+				go func() {
+					select {
+					case sig := <-signals:
+						stdLog.Printf("Got signal %q; closing remaining connections", sig)
+						cancel()
+					case <-ctx.Done():
+					}
+				}()
+
+				stdLog.Printf("Shutting down server: %s", message(endpoint.Shutdown(ctx)))
 			}()
 
-			stdLog.Printf("Server finished: %s", message(cmuxServer.Serve()))
+			err := endpoint.Serve()
+			switch {
+			case err == nil:
+				stdLog.Printf("Server finished: ok")
+			case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+				// Forcibly closing connections at the end of the
+				// grace period is an expected outcome of shutting down.
+				stdLog.Printf("Server finished: closed connections still active after shutdown grace period")
+			default:
+				errLog.Printf("Server finished: %s", message(err))
+				os.Exit(1)
+			}
 		},
 	}
 	rootCmd.AddCommand(runCmd)
+	runCmd.Flags().StringVarP(
+		&configFilePath,
+		"config",
+		"c",
+		"",
+		"The path to a YAML or JSON server configuration file. Flags take precedence over the values in the file.")
+	runCmd.Flags().StringVar(
+		&seedFilePath,
+		"seed",
+		"",
+		"The path to a file of users, rooms, blurbs and sequences to create on startup, in the JSON (if its extension is .json) or text format of google.showcase.v1beta1.Seed. Blurbs may refer to seeded users and rooms by alias as ${alias}. Takes precedence over the seed section of the configuration file.")
 	runCmd.Flags().StringVarP(
 		&config.port,
 		"port",
 		"p",
 		":7469",
 		"The port that showcase will be served on.")
+	runCmd.Flags().StringArrayVar(
+		&config.listen,
+		"listen",
+		nil,
+		"An address that showcase will be served on, overriding --port: \"unix:///path/to/socket\" for a Unix domain socket, or \"host:port\" or \":port\" for TCP. May be repeated to serve on several addresses. Clients reach a socket with --address unix:///path/to/socket.")
 	runCmd.Flags().StringVarP(
 		&config.fallbackPort,
 		"fallback-port",
@@ -71,15 +151,105 @@ func init() {
 		&config.tlsCaCert,
 		"mtls-ca-cert",
 		"",
-		"The Root CA certificate path for custom mutual TLS channel.")
+		"The Root CA certificate path for custom mutual TLS channel. If given, clients must present a certificate it signed.")
 	runCmd.Flags().StringVar(
 		&config.tlsCert,
 		"mtls-cert",
 		"",
-		"The server certificate path for custom mutual TLS channel.")
+		"The server certificate path for custom mutual TLS channel. Along with --mtls-key, serves gRPC, REST and gRPC-Web over TLS.")
 	runCmd.Flags().StringVar(
 		&config.tlsKey,
 		"mtls-key",
 		"",
 		"The server private key path for custom mutual TLS channel.")
+	runCmd.Flags().StringVar(
+		&config.selfSignedDir,
+		"generate-self-signed",
+		"",
+		"A directory to which a throwaway CA certificate (ca.pem), a server certificate for localhost (server.pem, server.key) and a client certificate (client.pem, client.key) are written on startup, for testing locally. The server then serves TLS with that server certificate; restart it with --mtls-ca-cert, --mtls-cert and --mtls-key to require client certificates.")
+	runCmd.Flags().DurationVar(
+		&config.shutdownTimeout,
+		"shutdown-timeout",
+		10*time.Second,
+		"The grace period for in-flight requests and streams to complete once a shutdown signal is received.")
+	runCmd.Flags().StringVar(
+		&config.recordFile,
+		"record",
+		"",
+		"The path to a file to which every call served is written, including its requests, responses, status, incoming metadata and timing. Credentials in the metadata are redacted.")
+	runCmd.Flags().StringVar(
+		&config.recordFormat,
+		"record-format",
+		server.CaptureFormatJSONL,
+		"The format of the --record file: \"jsonl\" for one JSON CapturedEvent per line, or \"proto\" for varint length-delimited binary CapturedEvent messages.")
+	runCmd.Flags().StringVar(
+		&config.replayFile,
+		"replay",
+		"",
+		"The path to a file written by --record. Calls are then answered with the recorded responses instead of being served, and fail with FAILED_PRECONDITION if they were not recorded.")
+	runCmd.Flags().StringVar(
+		&config.replayFormat,
+		"replay-format",
+		server.CaptureFormatJSONL,
+		"The format of the --replay file, as for --record-format.")
+	runCmd.Flags().StringVar(
+		&config.stateDir,
+		"state-dir",
+		"",
+		"The directory in which the users, rooms and blurbs are saved periodically and on shutdown. The state saved there is restored on startup, in which case the seed data is not loaded.")
+	runCmd.Flags().DurationVar(
+		&config.stateInterval,
+		"state-interval",
+		time.Minute,
+		"How often the state is saved to --state-dir. If zero, it is only saved on shutdown.")
+	runCmd.Flags().StringArrayVar(
+		&config.authTokens,
+		"auth-token",
+		nil,
+		"A bearer token that calls may carry, optionally followed by a colon and the comma-separated services it grants access to, as in \"secret:Echo,Identity\". May be repeated. Once any credential is accepted, calls to the Showcase services without an accepted credential fail with UNAUTHENTICATED or PERMISSION_DENIED.")
+	runCmd.Flags().StringArrayVar(
+		&config.authAPIKeys,
+		"auth-api-key",
+		nil,
+		"An API key that calls may carry in the x-goog-api-key header, given as for --auth-token. May be repeated.")
+	runCmd.Flags().BoolVar(
+		&config.authJWT,
+		"auth-jwt",
+		false,
+		"Accept the JWTs issued by the Admin service as bearer tokens, signing them with a key generated on startup unless --auth-jwt-key is given.")
+	runCmd.Flags().StringVar(
+		&config.authJWTKey,
+		"auth-jwt-key",
+		"",
+		"The path to an RSA private key in PEM format with which to sign the JWTs issued by the Admin service, which are then accepted as bearer tokens. Implies --auth-jwt.")
+	runCmd.Flags().StringSliceVar(
+		&config.compressors,
+		"compressors",
+		[]string{compression.Gzip, compression.Deflate},
+		"The compressors enabled for gRPC messages and REST bodies, in order of preference: any of gzip and deflate. Requests compressed otherwise are rejected, and responses are compressed as the client asks.")
+	runCmd.Flags().StringArrayVar(
+		&config.corsOrigins,
+		"cors-origin",
+		nil,
+		"An origin, such as \"http://localhost:8080\", from which browsers may call the REST and gRPC-Web endpoints, or \"*\" for any origin. Only the origins given explicitly may make calls carrying credentials. May be repeated. CORS is disabled unless some origin is given.")
+	runCmd.Flags().StringArrayVar(
+		&config.corsHeaders,
+		"cors-header",
+		nil,
+		"A request header that cross-origin calls may carry, besides Content-Type, Authorization, x-goog-*, x-showcase-* and the gRPC-Web headers. A trailing \"*\" matches any suffix. May be repeated.")
+	runCmd.Flags().StringArrayVar(
+		&config.corsExposedHeaders,
+		"cors-expose-header",
+		nil,
+		"A response header that cross-origin callers may read, besides the gRPC status trailers and the headers set on the response. May be repeated.")
+	runCmd.Flags().DurationVar(
+		&config.corsMaxAge,
+		"cors-max-age",
+		10*time.Minute,
+		"How long browsers may cache the answers to preflight requests.")
+	runCmd.Flags().BoolVar(
+		&config.clockHeader,
+		"clock-header",
+		false,
+		"Let calls change the server clock through the x-showcase-clock header. The clock is shared by all tenants, so calls carrying the header are rejected unless this is set.")
 }
@@ -5,6 +5,10 @@ package main
 import (
 	"github.com/spf13/cobra"
 
+	anypb "github.com/golang/protobuf/ptypes/any"
+
+	durationpb "github.com/golang/protobuf/ptypes/duration"
+
 	"fmt"
 
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
@@ -12,6 +16,8 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 
 	"os"
+
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
 )
 
 var SearchBlurbsInput genprotopb.SearchBlurbsRequest
@@ -22,9 +28,15 @@ var SearchBlurbsFollow bool
 
 var SearchBlurbsPollOperation string
 
+var SearchBlurbsInputErrorDetails []string
+
 func init() {
 	MessagingServiceCmd.AddCommand(SearchBlurbsCmd)
 
+	SearchBlurbsInput.Duration = new(durationpb.Duration)
+
+	SearchBlurbsInput.Error = new(statuspb.Status)
+
 	SearchBlurbsCmd.Flags().StringVar(&SearchBlurbsInput.Query, "query", "", "Required. The query used to search for blurbs containing to...")
 
 	SearchBlurbsCmd.Flags().StringVar(&SearchBlurbsInput.Parent, "parent", "", "The rooms or profiles to search. If unset,...")
@@ -33,6 +45,20 @@ func init() {
 
 	SearchBlurbsCmd.Flags().StringVar(&SearchBlurbsInput.PageToken, "page_token", "", "The value of ...")
 
+	SearchBlurbsCmd.Flags().Int32Var(&SearchBlurbsInput.PollCount, "poll_count", 0, "The number of times the operation must be polled...")
+
+	SearchBlurbsCmd.Flags().Int64Var(&SearchBlurbsInput.Duration.Seconds, "duration.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	SearchBlurbsCmd.Flags().Int32Var(&SearchBlurbsInput.Duration.Nanos, "duration.nanos", 0, "Signed fractions of a second at nanosecond...")
+
+	SearchBlurbsCmd.Flags().Int32Var(&SearchBlurbsInput.Error.Code, "error.code", 0, "The status code, which should be an enum value of...")
+
+	SearchBlurbsCmd.Flags().StringVar(&SearchBlurbsInput.Error.Message, "error.message", "", "A developer-facing error message, which should be...")
+
+	SearchBlurbsCmd.Flags().StringArrayVar(&SearchBlurbsInputErrorDetails, "error.details", []string{}, "A list of messages that carry the error details. ...")
+
+	SearchBlurbsCmd.Flags().Int32Var(&SearchBlurbsInput.FailAtPercent, "fail_at_percent", 0, "The progress, in percent, at which the operation...")
+
 	SearchBlurbsCmd.Flags().StringVar(&SearchBlurbsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 	SearchBlurbsCmd.Flags().BoolVar(&SearchBlurbsFollow, "follow", false, "Block until the long running operation completes")
@@ -77,6 +103,17 @@ var SearchBlurbsCmd = &cobra.Command{
 
 		}
 
+		// unmarshal JSON strings into slice of structs
+		for _, item := range SearchBlurbsInputErrorDetails {
+			tmp := anypb.Any{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			SearchBlurbsInput.Error.Details = append(SearchBlurbsInput.Error.Details, &tmp)
+		}
+
 		if Verbose {
 			printVerboseInput("Messaging", "SearchBlurbs", &SearchBlurbsInput)
 		}
@@ -0,0 +1,287 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// seedFile is the schema of the `seed` section of the server
+// configuration file. Each entry holds the fields of the resource to
+// create, using their proto or JSON names. User and room entries may
+// additionally hold the `alias` by which blurbs refer to them, and blurb
+// entries hold the `parent` under which to create the blurb, as in the
+// fields of pb.Seed. For example:
+//
+//	seed:
+//	  users:
+//	    - alias: alice
+//	      display_name: Alice
+//	      email: alice@example.com
+//	  blurbs:
+//	    - parent: ${alice}/profile
+//	      user: ${alice}
+//	      text: Hello
+type seedFile struct {
+	Users     []map[string]interface{} `mapstructure:"users"`
+	Rooms     []map[string]interface{} `mapstructure:"rooms"`
+	Blurbs    []map[string]interface{} `mapstructure:"blurbs"`
+	Sequences []map[string]interface{} `mapstructure:"sequences"`
+}
+
+func init() {
+	registerConfigSection("seed", configSection{load: loadSeedSection})
+}
+
+// loadSeedSection reads the seed section in v into config.
+func loadSeedSection(v *viper.Viper, _ *pflag.FlagSet, config *RuntimeConfig) error {
+	file := &seedFile{}
+	if err := v.UnmarshalExact(file); err != nil {
+		return err
+	}
+	seed, err := file.parse()
+	if err != nil {
+		return err
+	}
+	config.seed = seed
+	return nil
+}
+
+// parse converts the entries in f into the resources they describe.
+func (f *seedFile) parse() (*pb.Seed, error) {
+	seed := &pb.Seed{}
+	for idx, entry := range f.Users {
+		alias, fields := splitEntry(entry, "alias")
+		user := &pb.User{}
+		if err := unmarshalEntry(fields, user); err != nil {
+			return nil, fmt.Errorf("users[%d]: %v", idx, err)
+		}
+		seed.Users = append(seed.Users, &pb.Seed_SeededUser{Alias: alias, User: user})
+	}
+	for idx, entry := range f.Rooms {
+		alias, fields := splitEntry(entry, "alias")
+		room := &pb.Room{}
+		if err := unmarshalEntry(fields, room); err != nil {
+			return nil, fmt.Errorf("rooms[%d]: %v", idx, err)
+		}
+		seed.Rooms = append(seed.Rooms, &pb.Seed_SeededRoom{Alias: alias, Room: room})
+	}
+	for idx, entry := range f.Blurbs {
+		parent, fields := splitEntry(entry, "parent")
+		blurb := &pb.Blurb{}
+		if err := unmarshalEntry(fields, blurb); err != nil {
+			return nil, fmt.Errorf("blurbs[%d]: %v", idx, err)
+		}
+		seed.Blurbs = append(seed.Blurbs, &pb.Seed_SeededBlurb{Parent: parent, Blurb: blurb})
+	}
+	for idx, entry := range f.Sequences {
+		sequence := &pb.Sequence{}
+		if err := unmarshalEntry(entry, sequence); err != nil {
+			return nil, fmt.Errorf("sequences[%d]: %v", idx, err)
+		}
+		seed.Sequences = append(seed.Sequences, sequence)
+	}
+	if err := validateSeed(seed); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// splitEntry separates the string value of key in entry, which is not a
+// field of the resource described by entry, from the resource's fields.
+func splitEntry(entry map[string]interface{}, key string) (string, map[string]interface{}) {
+	value, _ := entry[key].(string)
+	fields := map[string]interface{}{}
+	for k, v := range entry {
+		if k != key {
+			fields[k] = v
+		}
+	}
+	return value, fields
+}
+
+// loadSeedFile reads the seed file at path, written in the JSON format
+// of pb.Seed if its extension is .json, or in its text format otherwise.
+func loadSeedFile(path string) (*pb.Seed, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	seed := &pb.Seed{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = jsonpb.Unmarshal(bytes.NewReader(b), seed)
+	} else {
+		err = proto.UnmarshalText(string(b), seed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid seed file %q: %v", path, err)
+	}
+	if err := validateSeed(seed); err != nil {
+		return nil, fmt.Errorf("invalid seed file %q: %v", path, err)
+	}
+	return seed, nil
+}
+
+// validateSeed checks that the aliases of seed are unique and that
+// every blurb has a parent and only refers to declared aliases, so that
+// errors are reported before the server starts.
+func validateSeed(seed *pb.Seed) error {
+	aliases := map[string]bool{}
+	checkAlias := func(alias, entry string) error {
+		if alias == "" {
+			return nil
+		}
+		if aliases[alias] {
+			return fmt.Errorf("%s: the alias %q is already used", entry, alias)
+		}
+		aliases[alias] = true
+		return nil
+	}
+	for idx, user := range seed.GetUsers() {
+		if err := checkAlias(user.GetAlias(), fmt.Sprintf("users[%d]", idx)); err != nil {
+			return err
+		}
+	}
+	for idx, room := range seed.GetRooms() {
+		if err := checkAlias(room.GetAlias(), fmt.Sprintf("rooms[%d]", idx)); err != nil {
+			return err
+		}
+	}
+	for idx, blurb := range seed.GetBlurbs() {
+		if blurb.GetParent() == "" {
+			return fmt.Errorf("blurbs[%d]: the field `parent` is required", idx)
+		}
+		for _, s := range []string{blurb.GetParent(), blurb.GetBlurb().GetUser()} {
+			for _, match := range aliasPlaceholder.FindAllStringSubmatch(s, -1) {
+				if !aliases[match[1]] {
+					return fmt.Errorf("blurbs[%d]: unknown alias %q", idx, match[1])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// unmarshalEntry populates msg from the generic representation of a
+// resource read from a configuration file.
+func unmarshalEntry(entry map[string]interface{}, msg proto.Message) error {
+	b, err := json.Marshal(jsonCompatible(entry))
+	if err != nil {
+		return err
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(b), msg)
+}
+
+// jsonCompatible converts the maps keyed by interface{} that YAML
+// decoders produce into maps keyed by string, recursively, so that v
+// can be marshalled as JSON.
+func jsonCompatible(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(value)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = jsonCompatible(value)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for idx, value := range v {
+			l[idx] = jsonCompatible(value)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// aliasPlaceholder matches the placeholders by which the blurbs of a
+// seed refer to seeded users and rooms.
+var aliasPlaceholder = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// seedBackend creates the resources in seed through the regular
+// Create* methods of backend, so that they are validated and named
+// exactly as if a client had created them. Users and rooms are created
+// before the blurbs that may refer to them.
+func seedBackend(backend *services.Backend, seed *pb.Seed) error {
+	ctx := context.Background()
+	names := map[string]string{}
+	for _, entry := range seed.GetUsers() {
+		created, err := backend.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{User: entry.GetUser()})
+		if err != nil {
+			return fmt.Errorf("seeding user %q: %v", entry.GetUser().GetDisplayName(), err)
+		}
+		if entry.GetAlias() != "" {
+			names[entry.GetAlias()] = created.GetName()
+		}
+		stdLog.Printf("Seeded %s", created.GetName())
+	}
+	for _, entry := range seed.GetRooms() {
+		created, err := backend.MessagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: entry.GetRoom()})
+		if err != nil {
+			return fmt.Errorf("seeding room %q: %v", entry.GetRoom().GetDisplayName(), err)
+		}
+		if entry.GetAlias() != "" {
+			names[entry.GetAlias()] = created.GetName()
+		}
+		stdLog.Printf("Seeded %s", created.GetName())
+	}
+
+	// resolve replaces the placeholders in s with the names of the
+	// resources they refer to.
+	resolve := func(s string) string {
+		return aliasPlaceholder.ReplaceAllStringFunc(s, func(placeholder string) string {
+			return names[aliasPlaceholder.FindStringSubmatch(placeholder)[1]]
+		})
+	}
+	for _, entry := range seed.GetBlurbs() {
+		req := &pb.CreateBlurbRequest{Parent: resolve(entry.GetParent()), Blurb: entry.GetBlurb()}
+		if req.Blurb != nil {
+			req.Blurb.User = resolve(req.Blurb.GetUser())
+		}
+		created, err := backend.MessagingServer.CreateBlurb(ctx, req)
+		if err != nil {
+			return fmt.Errorf("seeding blurb in %q: %v", req.GetParent(), err)
+		}
+		stdLog.Printf("Seeded %s", created.GetName())
+	}
+	for _, sequence := range seed.GetSequences() {
+		created, err := backend.SequenceServiceServer.CreateSequence(ctx, &pb.CreateSequenceRequest{Sequence: sequence})
+		if err != nil {
+			return fmt.Errorf("seeding sequence: %v", err)
+		}
+		stdLog.Printf("Seeded %s", created.GetName())
+	}
+	return nil
+}
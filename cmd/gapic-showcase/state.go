@@ -0,0 +1,164 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// stateFileName is the name of the snapshot file kept in the directory
+// given to `gapic-showcase run --state-dir`.
+const stateFileName = "state.json"
+
+// stateSection is the schema of the `state` section of the server
+// configuration file, configuring the snapshots saved by --state-dir. For
+// example:
+//
+//	state:
+//	  dir: /var/lib/showcase
+//	  interval: 5m
+type stateSection struct {
+	Dir      string        `mapstructure:"dir"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+func init() {
+	registerConfigSection("state", configSection{load: loadStateSection, validate: validateState})
+}
+
+// loadStateSection reads the state section in v into config.
+func loadStateSection(v *viper.Viper, flags *pflag.FlagSet, config *RuntimeConfig) error {
+	section := &stateSection{}
+	if err := v.UnmarshalExact(section); err != nil {
+		return err
+	}
+	overrideFromFile(v, flags, "dir", "state-dir", func() { config.stateDir = section.Dir })
+	overrideFromFile(v, flags, "interval", "state-interval", func() { config.stateInterval = section.Interval })
+	return nil
+}
+
+// validateState checks the interval between snapshots.
+func validateState(config *RuntimeConfig) error {
+	if config.stateInterval < 0 {
+		return fmt.Errorf("state interval must not be negative: %s", config.stateInterval)
+	}
+	return nil
+}
+
+// restoreState loads the snapshot kept in dir, if any, into backend. It
+// returns whether a snapshot was found.
+func restoreState(dir string, backend *services.Backend) (bool, error) {
+	path := filepath.Join(dir, stateFileName)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := services.LoadState(backend, data); err != nil {
+		return false, fmt.Errorf("invalid snapshot %q: %v", path, err)
+	}
+	stdLog.Printf("Showcase restored state from %s", path)
+	return true, nil
+}
+
+// stateSnapshots periodically saves the state of a backend to a
+// snapshot file.
+type stateSnapshots struct {
+	dir     string
+	backend *services.Backend
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// startSnapshots saves the state of backend to a snapshot in dir every
+// interval, or only once the snapshots are closed if interval is zero.
+func startSnapshots(dir string, interval time.Duration, backend *services.Backend) (*stateSnapshots, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &stateSnapshots{
+		dir:     dir,
+		backend: backend,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run(interval)
+	stdLog.Printf("Showcase saving state to %s", filepath.Join(dir, stateFileName))
+	return s, nil
+}
+
+func (s *stateSnapshots) run(interval time.Duration) {
+	defer close(s.done)
+	if interval == 0 {
+		<-s.stop
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.save(); err != nil {
+				errLog.Printf("Showcase failed to save state: %v", err)
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// save writes a snapshot of the backend. The previous snapshot is
+// replaced atomically, so that it remains intact if the server stops
+// while writing.
+func (s *stateSnapshots) save() error {
+	data, err := services.SaveState(s.backend)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.dir, stateFileName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(s.dir, stateFileName))
+}
+
+// Close stops the periodic snapshots and saves a final one.
+func (s *stateSnapshots) Close() error {
+	close(s.stop)
+	<-s.done
+	if err := s.save(); err != nil {
+		return fmt.Errorf("saving state to %s failed: %v", s.dir, err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// selfSignedValidity is how long the certificates written by
+// --generate-self-signed are valid for.
+const selfSignedValidity = 30 * 24 * time.Hour
+
+// serverTLSConfig returns the TLS configuration of the server, or nil if
+// no server certificate is configured. Client certificates signed by
+// config.tlsCaCert are required if it is given.
+//
+// TLS is terminated before connections are multiplexed, so the protocol
+// negotiated through ALPN decides how a client speaks: clients offering
+// HTTP/1.1, such as browsers, are served REST and gRPC-Web over HTTP/1.1,
+// while gRPC clients, which only offer h2, are served over HTTP/2.
+func (config *RuntimeConfig) serverTLSConfig() (*tls.Config, error) {
+	if config.tlsCert == "" || config.tlsKey == "" {
+		return nil, nil
+	}
+	keyPair, err := tls.LoadX509KeyPair(config.tlsCert, config.tlsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server TLS cert/key: %v", err)
+	}
+	base := &tls.Config{Certificates: []tls.Certificate{keyPair}}
+	if config.tlsCaCert != "" {
+		cert, err := ioutil.ReadFile(config.tlsCaCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load root CA cert file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cert) {
+			return nil, fmt.Errorf("no certificate in root CA cert file %s", config.tlsCaCert)
+		}
+		base.ClientCAs = pool
+		base.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	base.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		c := base.Clone()
+		c.GetConfigForClient = nil
+		switch {
+		case contains(hello.SupportedProtos, "http/1.1"):
+			c.NextProtos = []string{"http/1.1"}
+		case contains(hello.SupportedProtos, "h2"):
+			c.NextProtos = []string{"h2"}
+		}
+		return c, nil
+	}
+	return base, nil
+}
+
+// clientTLSConfig returns the TLS configuration of a client of the
+// server, verifying the server certificate with caCert, or with the
+// system roots if it is empty, and presenting the client certificate in
+// cert and key if given.
+func clientTLSConfig(caCert, cert, key string) (*tls.Config, error) {
+	config := &tls.Config{}
+	if caCert != "" {
+		ca, err := ioutil.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load root CA cert file: %v", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate in root CA cert file %s", caCert)
+		}
+	}
+	if cert != "" || key != "" {
+		keyPair, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client TLS cert/key: %v", err)
+		}
+		config.Certificates = []tls.Certificate{keyPair}
+	}
+	return config, nil
+}
+
+// generateSelfSigned writes to dir a throwaway CA certificate (ca.pem), a
+// server certificate and key for localhost (server.pem, server.key) and
+// a client certificate and key (client.pem, client.key), both signed by
+// the CA, for testing TLS and mTLS locally.
+func generateSelfSigned(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	now := time.Now()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	caTemplate := &x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"GAPIC Showcase"}, CommonName: "GAPIC Showcase test CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := createCertificate(caTemplate, caTemplate, caKey, caKey)
+	if err != nil {
+		return err
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return err
+	}
+	if err := writePEM(filepath.Join(dir, "ca.pem"), "CERTIFICATE", caDER, 0644); err != nil {
+		return err
+	}
+
+	leaves := []struct {
+		name     string
+		template *x509.Certificate
+	}{
+		{"server", &x509.Certificate{
+			Subject:     pkix.Name{Organization: []string{"GAPIC Showcase"}, CommonName: "localhost"},
+			DNSNames:    []string{"localhost"},
+			IPAddresses: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}},
+		{"client", &x509.Certificate{
+			Subject:     pkix.Name{Organization: []string{"GAPIC Showcase"}, CommonName: "GAPIC Showcase test client"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}},
+	}
+	for _, leaf := range leaves {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		leaf.template.NotBefore = now.Add(-time.Hour)
+		leaf.template.NotAfter = now.Add(selfSignedValidity)
+		leaf.template.KeyUsage = x509.KeyUsageDigitalSignature
+		der, err := createCertificate(leaf.template, ca, key, caKey)
+		if err != nil {
+			return err
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return err
+		}
+		if err := writePEM(filepath.Join(dir, leaf.name+".pem"), "CERTIFICATE", der, 0644); err != nil {
+			return err
+		}
+		if err := writePEM(filepath.Join(dir, leaf.name+".key"), "EC PRIVATE KEY", keyDER, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createCertificate signs template, with a random serial number, as
+// issued by parent.
+func createCertificate(template, parent *x509.Certificate, key, parentKey *ecdsa.PrivateKey) ([]byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = serial
+	return x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+}
+
+func writePEM(path, blockType string, der []byte, perm os.FileMode) error {
+	return ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), perm)
+}
@@ -71,6 +71,8 @@ func init() {
 
 	WaitCmd.Flags().StringVar(&WaitInputResponseSuccess.Success.Content, "response.success.content", "", "This content of the result.")
 
+	WaitCmd.Flags().StringSliceVar(&WaitInput.Stages, "stages", []string{}, "The stages the operation goes through, each for...")
+
 	WaitCmd.Flags().StringVar(&WaitInputEnd, "end", "", "Choices: end_time, ttl")
 
 	WaitCmd.Flags().StringVar(&WaitInputResponse, "response", "", "Choices: error, success")
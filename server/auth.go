@@ -0,0 +1,330 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying the credentials of a call. Over REST, they are
+// sent as HTTP headers.
+const (
+	// AuthorizationHeader holds a bearer token, as "Bearer <token>".
+	AuthorizationHeader = "authorization"
+
+	// APIKeyHeader holds an API key.
+	APIKeyHeader = "x-goog-api-key"
+)
+
+// authDomain is the domain of the ErrorInfo details of authentication
+// and authorization failures.
+const authDomain = "googleapis.com"
+
+// jwtIssuer is the issuer of the JWTs signed by the server.
+const jwtIssuer = "gapic-showcase"
+
+// Credential is a bearer token or an API key accepted by the server.
+type Credential struct {
+	Value string
+
+	// Services lists the services, such as "Echo", that the credential
+	// grants access to. It grants access to all of them if empty.
+	Services []string
+}
+
+// ParseCredential parses a credential given as its value, optionally
+// followed by a colon and a comma-separated list of the services it
+// grants access to, such as "secret:Echo,Identity".
+func ParseCredential(s string) (Credential, error) {
+	c := Credential{Value: s}
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		c.Value = s[:i]
+		for _, service := range strings.Split(s[i+1:], ",") {
+			if service = strings.TrimSpace(service); service != "" {
+				c.Services = append(c.Services, service)
+			}
+		}
+	}
+	if c.Value == "" {
+		return c, fmt.Errorf("empty credential in %q", s)
+	}
+	return c, nil
+}
+
+// grants returns whether c grants access to service.
+func (c Credential) grants(service string) bool {
+	return grants(c.Services, service)
+}
+
+func grants(services []string, service string) bool {
+	if len(services) == 0 {
+		return true
+	}
+	for _, s := range services {
+		if strings.EqualFold(s, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthConfig describes the credentials that calls to the Showcase
+// services must carry.
+type AuthConfig struct {
+	// Tokens are the accepted bearer tokens.
+	Tokens []Credential
+
+	// APIKeys are the accepted API keys.
+	APIKeys []Credential
+
+	// JWTKey, if not nil, signs the JWTs issued by the server, which are
+	// accepted as bearer tokens until they expire.
+	JWTKey *rsa.PrivateKey
+}
+
+// Enabled returns whether calls must carry credentials.
+func (c AuthConfig) Enabled() bool {
+	return len(c.Tokens) > 0 || len(c.APIKeys) > 0 || c.JWTKey != nil
+}
+
+// Authenticator provides interceptors that reject the calls to the
+// Showcase services that do not carry credentials accepted by the
+// server, with UNAUTHENTICATED, or whose credentials do not grant access
+// to the service called, with PERMISSION_DENIED. Both errors carry an
+// ErrorInfo detail whose reason tells what is wrong. Calls to the Admin
+// service and to gRPC infrastructure services are left untouched.
+type Authenticator struct {
+	config AuthConfig
+	nowF   func() time.Time
+}
+
+// NewAuthenticator returns an Authenticator accepting the credentials
+// described by config. JWTs expire according to the server clock.
+func NewAuthenticator(config AuthConfig) *Authenticator {
+	return &Authenticator{config: config, nowF: GetClockInstance().Now}
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor.
+func (a *Authenticator) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor.
+func (a *Authenticator) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, method string) error {
+	if strings.HasPrefix(method, "/grpc.") || strings.HasPrefix(method, adminMethodPrefix) {
+		return nil
+	}
+	service := shortServiceName(method)
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization, hasToken := lastValue(md, AuthorizationHeader)
+	key, hasKey := lastValue(md, APIKeyHeader)
+	if !hasToken && !hasKey {
+		return authError(codes.Unauthenticated, "CREDENTIALS_MISSING", method,
+			"Request is missing required authentication credential. Expected a bearer token or an API key.")
+	}
+
+	if hasKey {
+		c, ok := findCredential(a.config.APIKeys, key)
+		if !ok {
+			return authError(codes.Unauthenticated, "API_KEY_INVALID", method, "API key not valid.")
+		}
+		if !c.grants(service) {
+			return authError(codes.PermissionDenied, "API_KEY_SERVICE_BLOCKED", method,
+				fmt.Sprintf("The API key does not grant access to the %s service.", service))
+		}
+	}
+
+	if hasToken {
+		scheme, token := authorization, ""
+		if i := strings.Index(authorization, " "); i >= 0 {
+			scheme, token = authorization[:i], strings.TrimSpace(authorization[i+1:])
+		}
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return authError(codes.Unauthenticated, "ACCESS_TOKEN_TYPE_UNSUPPORTED", method,
+				"Unsupported authorization: expected a bearer token.")
+		}
+		services, err := a.tokenServices(token)
+		if errors.Is(err, errTokenExpired) {
+			return authError(codes.Unauthenticated, "ACCESS_TOKEN_EXPIRED", method, "The access token has expired.")
+		}
+		if err != nil {
+			return authError(codes.Unauthenticated, "ACCESS_TOKEN_INVALID", method, "Invalid access token.")
+		}
+		if !grants(services, service) {
+			return authError(codes.PermissionDenied, "ACCESS_TOKEN_SCOPE_INSUFFICIENT", method,
+				fmt.Sprintf("The access token does not grant access to the %s service.", service))
+		}
+	}
+	return nil
+}
+
+// tokenServices returns the services that token grants access to.
+func (a *Authenticator) tokenServices(token string) ([]string, error) {
+	if c, ok := findCredential(a.config.Tokens, token); ok {
+		return c.Services, nil
+	}
+	if a.config.JWTKey == nil {
+		return nil, errTokenInvalid
+	}
+	claims, err := a.verifyJWT(token)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(claims.Scope), nil
+}
+
+func findCredential(credentials []Credential, value string) (Credential, bool) {
+	for _, c := range credentials {
+		if c.Value == value {
+			return c, true
+		}
+	}
+	return Credential{}, false
+}
+
+// shortServiceName returns the name of the service of a method without
+// its package, such as "Echo" for "/google.showcase.v1beta1.Echo/Echo".
+func shortServiceName(method string) string {
+	service := strings.TrimPrefix(method, "/")
+	if i := strings.Index(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	return service[strings.LastIndex(service, ".")+1:]
+}
+
+func authError(code codes.Code, reason, method, message string) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: authDomain,
+		Metadata: map[string]string{
+			"service": "showcase.googleapis.com",
+			"method":  strings.Replace(strings.TrimPrefix(method, "/"), "/", ".", 1),
+		},
+	})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+var (
+	errTokenInvalid = errors.New("invalid token")
+	errTokenExpired = errors.New("token expired")
+)
+
+// jwtHeader is the only JOSE header of the JWTs signed by the server.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+
+// jwtClaims are the claims of the JWTs signed by the server. Scope lists
+// the services that the token grants access to, separated by spaces, or
+// is empty if it grants access to all of them.
+type jwtClaims struct {
+	Issuer   string `json:"iss"`
+	Subject  string `json:"sub,omitempty"`
+	IssuedAt int64  `json:"iat"`
+	Expiry   int64  `json:"exp"`
+	Scope    string `json:"scope,omitempty"`
+}
+
+// IssueToken returns a JWT for subject granting access to services, or
+// to all of them if empty, along with the time at which it expires
+// according to the server clock.
+func (a *Authenticator) IssueToken(subject string, services []string, ttl time.Duration) (string, time.Time, error) {
+	if a.config.JWTKey == nil {
+		return "", time.Time{}, errors.New("the server does not sign JWTs")
+	}
+	now := a.nowF()
+	expiry := now.Add(ttl)
+	claims, err := json.Marshal(jwtClaims{
+		Issuer:   jwtIssuer,
+		Subject:  subject,
+		IssuedAt: now.Unix(),
+		Expiry:   expiry.Unix(),
+		Scope:    strings.Join(services, " "),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	signed := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, a.config.JWTKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), time.Unix(expiry.Unix(), 0), nil
+}
+
+// verifyJWT returns the claims of token if it was signed by the server
+// and has not expired.
+func (a *Authenticator) verifyJWT(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return nil, errTokenInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errTokenInvalid
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&a.config.JWTKey.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, errTokenInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errTokenInvalid
+	}
+	claims := &jwtClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil || claims.Issuer != jwtIssuer {
+		return nil, errTokenInvalid
+	}
+	if !a.nowF().Before(time.Unix(claims.Expiry, 0)) {
+		return nil, errTokenExpired
+	}
+	return claims, nil
+}
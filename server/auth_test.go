@@ -0,0 +1,133 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseCredential(t *testing.T) {
+	c, err := ParseCredential("secret:Echo, Identity")
+	if err != nil {
+		t.Fatalf("ParseCredential: unexpected err %+v", err)
+	}
+	if c.Value != "secret" || len(c.Services) != 2 || c.Services[1] != "Identity" {
+		t.Errorf("ParseCredential: got %+v", c)
+	}
+	if c, _ := ParseCredential("secret"); c.Value != "secret" || len(c.Services) != 0 {
+		t.Errorf("ParseCredential: want a credential for all services, got %+v", c)
+	}
+	if _, err := ParseCredential(":Echo"); err == nil {
+		t.Errorf("ParseCredential: want error for an empty credential")
+	}
+}
+
+func TestAuthenticator(t *testing.T) {
+	a := NewAuthenticator(AuthConfig{
+		Tokens:  []Credential{{Value: "token"}, {Value: "echo-token", Services: []string{"Echo"}}},
+		APIKeys: []Credential{{Value: "key", Services: []string{"Identity"}}},
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		method string
+		md     metadata.MD
+		code   codes.Code
+		reason string
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(), codes.Unauthenticated, "CREDENTIALS_MISSING"},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(AuthorizationHeader, "Bearer token"), codes.OK, ""},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(AuthorizationHeader, "Bearer echo-token"), codes.OK, ""},
+		{"/google.showcase.v1beta1.Identity/GetUser", metadata.Pairs(AuthorizationHeader, "Bearer echo-token"), codes.PermissionDenied, "ACCESS_TOKEN_SCOPE_INSUFFICIENT"},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(AuthorizationHeader, "Bearer bogus"), codes.Unauthenticated, "ACCESS_TOKEN_INVALID"},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(AuthorizationHeader, "Basic token"), codes.Unauthenticated, "ACCESS_TOKEN_TYPE_UNSUPPORTED"},
+		{"/google.showcase.v1beta1.Identity/GetUser", metadata.Pairs(APIKeyHeader, "key"), codes.OK, ""},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(APIKeyHeader, "key"), codes.PermissionDenied, "API_KEY_SERVICE_BLOCKED"},
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.Pairs(APIKeyHeader, "bogus"), codes.Unauthenticated, "API_KEY_INVALID"},
+		{"/google.showcase.v1beta1.Admin/GetStats", metadata.Pairs(), codes.OK, ""},
+		{"/grpc.health.v1.Health/Check", metadata.Pairs(), codes.OK, ""},
+	}
+	for _, test := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), test.md)
+		info := &grpc.UnaryServerInfo{FullMethod: test.method}
+		_, err := a.UnaryInterceptor(ctx, nil, info, handler)
+		if got := status.Code(err); got != test.code {
+			t.Errorf("%s %v: want %v, got %v", test.method, test.md, test.code, err)
+			continue
+		}
+		if test.reason == "" {
+			continue
+		}
+		if got := errorInfo(err); got == nil || got.GetReason() != test.reason || got.GetDomain() != authDomain {
+			t.Errorf("%s %v: want ErrorInfo with reason %s, got %v", test.method, test.md, test.reason, got)
+		}
+	}
+}
+
+func TestAuthenticator_JWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := NewClock()
+	clock.Freeze()
+	a := &Authenticator{config: AuthConfig{JWTKey: key}, nowF: clock.Now}
+
+	token, expiry, err := a.IssueToken("alice", []string{"Echo"}, time.Minute)
+	if err != nil {
+		t.Fatalf("IssueToken: unexpected err %+v", err)
+	}
+	if want := clock.Now().Add(time.Minute).Truncate(time.Second); !expiry.Equal(want) {
+		t.Errorf("IssueToken: want expiry %v, got %v", want, expiry)
+	}
+
+	call := func(method, token string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+token))
+		return a.authenticate(ctx, method)
+	}
+	if err := call("/google.showcase.v1beta1.Echo/Echo", token); err != nil {
+		t.Errorf("JWT: unexpected err %+v", err)
+	}
+	if err := call("/google.showcase.v1beta1.Identity/GetUser", token); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("JWT: want PermissionDenied outside its scope, got %v", err)
+	}
+	if err := call("/google.showcase.v1beta1.Echo/Echo", token[:len(token)-4]+"AAAA"); errorInfo(err).GetReason() != "ACCESS_TOKEN_INVALID" {
+		t.Errorf("JWT: want a tampered token to be invalid, got %v", err)
+	}
+
+	clock.Advance(time.Minute)
+	if err := call("/google.showcase.v1beta1.Echo/Echo", token); errorInfo(err).GetReason() != "ACCESS_TOKEN_EXPIRED" {
+		t.Errorf("JWT: want an expired token once the clock is advanced, got %v", err)
+	}
+}
+
+func errorInfo(err error) *errdetails.ErrorInfo {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Transports through which calls reach the Showcase services.
+const (
+	TransportGRPC    = "grpc"
+	TransportGRPCWeb = "grpc-web"
+	TransportREST    = "rest"
+)
+
+// CallInfo describes a call handled by the observer registry. It is
+// attached to the context of the call, so that observers can retrieve it
+// with CallInfoFromContext.
+type CallInfo struct {
+	// ID identifies the call among all the calls handled by the process.
+	ID uint64
+
+	// Transport is TransportGRPC, TransportGRPCWeb or TransportREST.
+	Transport string
+
+	// Start is the time at which the registry started handling the call.
+	Start time.Time
+
+	// Deadline is the deadline of the call, or the zero time if the
+	// client did not set any.
+	Deadline time.Time
+
+	// RequestCompression and ResponseCompression are the compression of
+	// the messages of the call, such as "gzip", or the empty string if
+	// they are not compressed.
+	RequestCompression  string
+	ResponseCompression string
+
+	mu      sync.Mutex
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+var lastCallID uint64
+
+type callInfoKey struct{}
+
+type transportKey struct{}
+
+// WithTransport returns a copy of ctx for calls received through the
+// given transport, for the transports that cannot be told apart from the
+// context alone, such as gRPC-Web.
+func WithTransport(ctx context.Context, transport string) context.Context {
+	return context.WithValue(ctx, transportKey{}, transport)
+}
+
+// newCallInfo returns the CallInfo for a call with the given context,
+// which must not have been handled by the registry yet.
+func newCallInfo(ctx context.Context) *CallInfo {
+	deadline, _ := ctx.Deadline()
+	requestCompression, responseCompression := compression.FromContext(ctx)
+	return &CallInfo{
+		ID:                  atomic.AddUint64(&lastCallID, 1),
+		Transport:           transport(ctx),
+		Start:               time.Now(),
+		Deadline:            deadline,
+		RequestCompression:  requestCompression,
+		ResponseCompression: responseCompression,
+	}
+}
+
+// transport returns the transport through which the call with the
+// given context was received.
+func transport(ctx context.Context) string {
+	if info, ok := CallInfoFromContext(ctx); ok {
+		return info.Transport
+	}
+	if transport, ok := ctx.Value(transportKey{}).(string); ok {
+		return transport
+	}
+	if grpc.ServerTransportStreamFromContext(ctx) != nil {
+		// Only calls received by a grpc.Server carry a transport
+		// stream; the others come from the REST endpoint.
+		return TransportGRPC
+	}
+	return TransportREST
+}
+
+// CallInfoFromContext returns the CallInfo of the call with the given
+// context, if it is handled by an observer registry.
+func CallInfoFromContext(ctx context.Context) (*CallInfo, bool) {
+	info, ok := ctx.Value(callInfoKey{}).(*CallInfo)
+	return info, ok
+}
+
+// Header returns the header metadata set by the handler of the call so far.
+func (c *CallInfo) Header() metadata.MD {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.header.Copy()
+}
+
+// Trailer returns the trailer metadata set by the handler of the call so far.
+func (c *CallInfo) Trailer() metadata.MD {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.trailer.Copy()
+}
+
+func (c *CallInfo) addHeader(md metadata.MD) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.header = metadata.Join(c.header, md)
+}
+
+func (c *CallInfo) addTrailer(md metadata.MD) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trailer = metadata.Join(c.trailer, md)
+}
+
+// callTransportStream records the metadata that the handler of a unary
+// call sets through grpc.SetHeader, grpc.SendHeader and grpc.SetTrailer
+// before passing it on to the underlying transport stream, if any.
+type callTransportStream struct {
+	method string
+	info   *CallInfo
+	stream grpc.ServerTransportStream
+}
+
+func (s *callTransportStream) Method() string {
+	return s.method
+}
+
+func (s *callTransportStream) SetHeader(md metadata.MD) error {
+	s.info.addHeader(md)
+	if s.stream == nil {
+		return nil
+	}
+	return s.stream.SetHeader(md)
+}
+
+func (s *callTransportStream) SendHeader(md metadata.MD) error {
+	s.info.addHeader(md)
+	if s.stream == nil {
+		return nil
+	}
+	return s.stream.SendHeader(md)
+}
+
+func (s *callTransportStream) SetTrailer(md metadata.MD) error {
+	s.info.addTrailer(md)
+	if s.stream == nil {
+		return nil
+	}
+	return s.stream.SetTrailer(md)
+}
+
+// withCallInfo returns a copy of ctx carrying info, in which the
+// metadata set by the handler of a unary call is recorded in info.
+func withCallInfo(ctx context.Context, method string, info *CallInfo) context.Context {
+	stream := &callTransportStream{method: method, info: info, stream: grpc.ServerTransportStreamFromContext(ctx)}
+	ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
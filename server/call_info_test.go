@@ -0,0 +1,45 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+func TestCallInfo_compression(t *testing.T) {
+	if err := compression.Register([]string{compression.Gzip}); err != nil {
+		t.Fatalf("Register: unexpected err %+v", err)
+	}
+	h := compression.StatsHandler{}
+	ctx := h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/google.showcase.v1beta1.Echo/Echo"})
+	h.HandleRPC(ctx, &stats.InHeader{Compression: compression.Gzip})
+
+	// The observer registry records it for each call.
+	registry := ShowcaseObserverRegistry()
+	var info *CallInfo
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		info, _ = CallInfoFromContext(ctx)
+		return nil, nil
+	}
+	registry.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}, handler)
+	if info == nil || info.RequestCompression != compression.Gzip || info.ResponseCompression != compression.Gzip {
+		t.Errorf("CallInfo: want gzip both ways, got %+v", info)
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ClockHeader is the metadata key through which a client changes the
+// server clock before its call is handled. It holds a comma-separated
+// list of changes, applied in order:
+//
+//	freeze         stops the clock
+//	resume         restarts a stopped clock
+//	reset          returns the clock to the real time, running
+//	advance=<d>    moves the clock forward by d, such as "90s"
+//	set=<t>        moves the clock to t, in RFC 3339 format
+//
+// Since the clock is shared by all tenants, servers only honor the
+// header when told to, and otherwise reject the calls carrying it with
+// ClockHeaderGuard.
+const ClockHeader = "x-showcase-clock"
+
+var clockSingleton = NewClock()
+
+// GetClockInstance returns the clock singleton, which tells the time of
+// the server.
+func GetClockInstance() *Clock {
+	return clockSingleton
+}
+
+// Clock is a virtual clock that stamps resources, completes long-running
+// operations and times the delays of the server. It follows the real
+// time until it is changed, after which it runs at the pace of the real
+// time, offset by the changes, or stands still while frozen.
+type Clock struct {
+	mu     sync.Mutex
+	offset time.Duration // the clock time minus the real time, while running
+	frozen bool
+	at     time.Time // the clock time, while frozen
+
+	// changed is closed and replaced whenever the clock is changed, to
+	// wake up the sleepers.
+	changed chan struct{}
+}
+
+// NewClock returns a new Clock following the real time.
+func NewClock() *Clock {
+	return &Clock{changed: make(chan struct{})}
+}
+
+// Now returns the time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nowLocked()
+}
+
+func (c *Clock) nowLocked() time.Time {
+	if c.frozen {
+		return c.at
+	}
+	return time.Now().Add(c.offset)
+}
+
+// Frozen returns whether the clock is stopped.
+func (c *Clock) Frozen() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.frozen
+}
+
+// Offset returns the clock time minus the real time.
+func (c *Clock) Offset() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nowLocked().Sub(time.Now())
+}
+
+// Freeze stops the clock.
+func (c *Clock) Freeze() {
+	c.update(func() {
+		c.at = c.nowLocked()
+		c.frozen = true
+	})
+}
+
+// Resume restarts the clock from the time at which it was stopped.
+func (c *Clock) Resume() {
+	c.update(func() {
+		c.offset = c.nowLocked().Sub(time.Now())
+		c.frozen = false
+	})
+}
+
+// Reset returns the clock to the real time, running.
+func (c *Clock) Reset() {
+	c.update(func() {
+		c.offset = 0
+		c.frozen = false
+	})
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.update(func() {
+		if c.frozen {
+			c.at = c.at.Add(d)
+		} else {
+			c.offset += d
+		}
+	})
+}
+
+// Set moves the clock to t.
+func (c *Clock) Set(t time.Time) {
+	c.update(func() {
+		if c.frozen {
+			c.at = t
+		} else {
+			c.offset = t.Sub(time.Now())
+		}
+	})
+}
+
+// update changes the clock with f and wakes up the sleepers.
+func (c *Clock) update(f func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f()
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// Sleep waits until the clock has moved forward by d, unless ctx is done
+// first, in which case it returns the status error matching ctx.Err().
+// While the clock is frozen, Sleep waits for it to be advanced.
+func (c *Clock) Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctxErr(ctx)
+	}
+	end := c.Now().Add(d)
+	for {
+		c.mu.Lock()
+		remaining := end.Sub(c.nowLocked())
+		frozen, changed := c.frozen, c.changed
+		c.mu.Unlock()
+		if remaining <= 0 {
+			return nil
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !frozen {
+			timer = time.NewTimer(remaining)
+			timeout = timer.C
+		}
+		var err error
+		select {
+		case <-timeout:
+		case <-changed:
+		case <-ctx.Done():
+			err = ctxErr(ctx)
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Apply applies the changes listed in the value of ClockHeader. If any
+// of them is invalid, none is applied.
+func (c *Clock) Apply(changes string) error {
+	updates := []func(){}
+	for _, change := range strings.Split(changes, ",") {
+		change = strings.TrimSpace(change)
+		op, arg := change, ""
+		if i := strings.Index(change, "="); i >= 0 {
+			op, arg = change[:i], change[i+1:]
+		}
+		switch op {
+		case "freeze":
+			updates = append(updates, c.Freeze)
+		case "resume":
+			updates = append(updates, c.Resume)
+		case "reset":
+			updates = append(updates, c.Reset)
+		case "advance":
+			d, err := time.ParseDuration(arg)
+			if err == nil && d < 0 {
+				err = fmt.Errorf("must not be negative")
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %v", change, err)
+			}
+			updates = append(updates, func() { c.Advance(d) })
+		case "set":
+			t, err := time.Parse(time.RFC3339Nano, arg)
+			if err != nil {
+				return fmt.Errorf("%s: %v", change, err)
+			}
+			updates = append(updates, func() { c.Set(t) })
+		default:
+			return fmt.Errorf("unknown change %q", change)
+		}
+	}
+	for _, update := range updates {
+		update()
+	}
+	return nil
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, applying the
+// changes requested through ClockHeader before handling the call.
+func (c *Clock) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := c.applyHeader(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, applying
+// the changes requested through ClockHeader before handling the stream.
+func (c *Clock) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := c.applyHeader(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (c *Clock) applyHeader(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	value, ok := lastValue(md, ClockHeader)
+	if !ok {
+		return nil
+	}
+	if err := c.Apply(value); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s header %q: %v", ClockHeader, value, err)
+	}
+	return nil
+}
+
+// ClockHeaderGuard rejects the calls carrying ClockHeader with
+// FAILED_PRECONDITION, on servers whose clients may not change the clock.
+type ClockHeaderGuard struct{}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor.
+func (ClockHeaderGuard) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkNoClockHeader(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor.
+func (ClockHeaderGuard) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := checkNoClockHeader(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func checkNoClockHeader(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if _, ok := lastValue(md, ClockHeader); ok {
+		return status.Errorf(codes.FailedPrecondition, "the %s header is not enabled on this server; change the clock through the Admin service instead", ClockHeader)
+	}
+	return nil
+}
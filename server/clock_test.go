@@ -0,0 +1,147 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestClock_freeze(t *testing.T) {
+	c := NewClock()
+	c.Freeze()
+	frozen := c.Now()
+	time.Sleep(5 * time.Millisecond)
+	if got := c.Now(); !got.Equal(frozen) {
+		t.Errorf("Now: want %v while frozen, got %v", frozen, got)
+	}
+
+	c.Advance(time.Hour)
+	if got, want := c.Now(), frozen.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("Advance: want %v, got %v", want, got)
+	}
+
+	at := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.Set(at)
+	if got := c.Now(); !got.Equal(at) {
+		t.Errorf("Set: want %v, got %v", at, got)
+	}
+
+	c.Resume()
+	if c.Frozen() {
+		t.Errorf("Resume: want a running clock")
+	}
+	if got := c.Now(); got.Before(at) || got.After(at.Add(time.Second)) {
+		t.Errorf("Resume: want the clock to run from %v, got %v", at, got)
+	}
+
+	c.Reset()
+	if offset := c.Offset(); offset < -time.Second || offset > time.Second {
+		t.Errorf("Reset: want the real time, got an offset of %v", offset)
+	}
+}
+
+func TestClock_Sleep(t *testing.T) {
+	c := NewClock()
+	c.Freeze()
+	done := make(chan error)
+	go func() { done <- c.Sleep(context.Background(), time.Minute) }()
+	time.Sleep(10 * time.Millisecond)
+
+	c.Advance(30 * time.Second)
+	select {
+	case err := <-done:
+		t.Fatalf("Sleep: want to sleep until the clock reaches the end, got %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	c.Advance(30 * time.Second)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Sleep: unexpected err %+v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Sleep: want to wake up once the clock is advanced")
+	}
+}
+
+func TestClock_Sleep_deadline(t *testing.T) {
+	c := NewClock()
+	c.Freeze()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.Sleep(ctx, time.Minute); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Sleep: want DeadlineExceeded while frozen, got %v", err)
+	}
+}
+
+func TestClock_Apply(t *testing.T) {
+	c := NewClock()
+	if err := c.Apply("freeze, set=2021-01-01T00:00:00Z, advance=90s"); err != nil {
+		t.Fatalf("Apply: unexpected err %+v", err)
+	}
+	if got, want := c.Now(), time.Date(2021, 1, 1, 0, 1, 30, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Apply: want %v, got %v", want, got)
+	}
+
+	for _, changes := range []string{"advance=-1s", "advance=soon", "set=yesterday", "rewind", "freeze,stop"} {
+		if err := c.Apply(changes); err == nil {
+			t.Errorf("Apply(%q): want error", changes)
+		}
+	}
+	if c.Frozen() != true {
+		t.Errorf("Apply: want no change applied from invalid changes")
+	}
+}
+
+func TestClock_UnaryInterceptor(t *testing.T) {
+	c := NewClock()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return c.Frozen(), nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ClockHeader, "freeze"))
+	frozen, err := c.UnaryInterceptor(ctx, nil, info, handler)
+	if err != nil {
+		t.Fatalf("UnaryInterceptor: unexpected err %+v", err)
+	}
+	if frozen != true {
+		t.Errorf("UnaryInterceptor: want the clock frozen before the call is handled")
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(ClockHeader, "later"))
+	if _, err := c.UnaryInterceptor(ctx, nil, info, handler); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UnaryInterceptor: want InvalidArgument, got %v", err)
+	}
+}
+
+func TestClockHeaderGuard(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "resp", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ClockHeader, "freeze"))
+	if _, err := (ClockHeaderGuard{}).UnaryInterceptor(ctx, nil, info, handler); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnaryInterceptor: want FailedPrecondition, got %v", err)
+	}
+	if resp, err := (ClockHeaderGuard{}).UnaryInterceptor(context.Background(), nil, info, handler); err != nil || resp != "resp" {
+		t.Errorf("UnaryInterceptor: want calls without the header handled, got %v, %v", resp, err)
+	}
+}
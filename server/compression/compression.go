@@ -0,0 +1,237 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compression holds the compressors that Showcase supports for
+// gRPC messages and REST bodies, and records the compression of each call
+// in its context. It is shared by the gRPC and REST endpoints.
+package compression
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/stats"
+)
+
+// Names of the compressors that Showcase supports, as they appear in the
+// grpc-encoding and Content-Encoding headers.
+const (
+	Gzip    = "gzip"
+	Deflate = "deflate"
+)
+
+// compressors are the compressors that Showcase supports. "deflate" is the
+// zlib format, as HTTP and gRPC implementations use it.
+var compressors = map[string]*compressor{
+	Gzip: {
+		name:      Gzip,
+		newWriter: func(w io.Writer) (io.WriteCloser, error) { return gzip.NewWriter(w), nil },
+		newReader: func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+	},
+	Deflate: {
+		name:      Deflate,
+		newWriter: func(w io.Writer) (io.WriteCloser, error) { return zlib.NewWriter(w), nil },
+		newReader: func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) },
+	},
+}
+
+var (
+	enabledMu          sync.RWMutex
+	enabledCompressors []string
+)
+
+// compressor implements encoding.Compressor.
+type compressor struct {
+	name      string
+	newWriter func(io.Writer) (io.WriteCloser, error)
+	newReader func(io.Reader) (io.Reader, error)
+}
+
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) { return c.newWriter(w) }
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error)    { return c.newReader(r) }
+func (c *compressor) Name() string                                 { return c.name }
+
+// Supported returns the names of the compressors that Showcase supports,
+// sorted.
+func Supported() []string {
+	names := make([]string, 0, len(compressors))
+	for name := range compressors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Register enables the named compressors for both the gRPC and REST
+// endpoints, in order of preference. Since gRPC compressors cannot be
+// unregistered, it should be called once, before serving.
+func Register(names []string) error {
+	enabled := []string{}
+	for _, name := range names {
+		c, ok := compressors[name]
+		if !ok {
+			return fmt.Errorf("unknown compressor %q: must be one of %s", name, strings.Join(Supported(), ", "))
+		}
+		encoding.RegisterCompressor(c)
+		enabled = append(enabled, name)
+	}
+
+	enabledMu.Lock()
+	defer enabledMu.Unlock()
+	enabledCompressors = enabled
+	return nil
+}
+
+// Enabled returns the names of the compressors enabled by Register, in
+// order of preference.
+func Enabled() []string {
+	enabledMu.RLock()
+	defer enabledMu.RUnlock()
+	return append([]string{}, enabledCompressors...)
+}
+
+func enabledCompressor(name string) (*compressor, bool) {
+	for _, enabled := range Enabled() {
+		if enabled == name {
+			return compressors[name], true
+		}
+	}
+	return nil, false
+}
+
+// Decompress returns a reader of the data of r, compressed with the named
+// enabled compressor.
+func Decompress(name string, r io.Reader) (io.Reader, error) {
+	c, ok := enabledCompressor(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported compression %q: must be one of %s", name, strings.Join(Enabled(), ", "))
+	}
+	return c.Decompress(r)
+}
+
+// Compress returns a writer compressing the data written to it with the
+// named enabled compressor before writing it to w. It must be closed to
+// flush the compressed data.
+func Compress(name string, w io.Writer) (io.WriteCloser, error) {
+	c, ok := enabledCompressor(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported compression %q: must be one of %s", name, strings.Join(Enabled(), ", "))
+	}
+	return c.Compress(w)
+}
+
+// NegotiateEncoding returns the enabled compressor that best matches the
+// value of an Accept-Encoding header, or the empty string if the
+// response should not be compressed. Compressors with the same quality
+// are chosen in the order in which they were enabled.
+func NegotiateEncoding(acceptEncoding string) string {
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		qualities[name] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, name := range Enabled() {
+		q, ok := qualities[name]
+		if !ok {
+			q = qualities["*"]
+		}
+		if q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+// callCompression is the compression of the messages of a call, or the
+// empty string for uncompressed messages.
+type callCompression struct {
+	request  string
+	response string
+}
+
+type compressionKey struct{}
+
+// NewContext returns a copy of ctx recording the compression of the
+// request and response of the call, for the REST endpoint.
+func NewContext(ctx context.Context, request, response string) context.Context {
+	return context.WithValue(ctx, compressionKey{}, &callCompression{request: request, response: response})
+}
+
+// FromContext returns the compression of the request and response of the
+// call with the given context. Either is the empty string when
+// uncompressed.
+func FromContext(ctx context.Context) (request, response string) {
+	if c, ok := ctx.Value(compressionKey{}).(*callCompression); ok {
+		return c.request, c.response
+	}
+	return "", ""
+}
+
+// StatsHandler records the compression of gRPC calls, so that it can be
+// retrieved with FromContext. It is installed with grpc.StatsHandler.
+type StatsHandler struct{}
+
+// TagRPC attaches to ctx the record of the compression of the call.
+func (StatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, compressionKey{}, &callCompression{})
+}
+
+// HandleRPC records the compression of the request once its header is
+// received. The server compresses its responses the same way whenever
+// it has a matching compressor.
+func (StatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	in, ok := s.(*stats.InHeader)
+	if !ok || in.IsClient() {
+		return
+	}
+	c, ok := ctx.Value(compressionKey{}).(*callCompression)
+	if !ok || in.Compression == "" || in.Compression == encoding.Identity {
+		return
+	}
+	c.request = in.Compression
+	if encoding.GetCompressor(in.Compression) != nil {
+		c.response = in.Compression
+	}
+}
+
+// TagConn returns ctx unchanged.
+func (StatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn does nothing.
+func (StatsHandler) HandleConn(context.Context, stats.ConnStats) {}
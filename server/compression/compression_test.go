@@ -0,0 +1,108 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"google.golang.org/grpc/stats"
+)
+
+func TestCompressors(t *testing.T) {
+	if err := Register([]string{Gzip, Deflate}); err != nil {
+		t.Fatalf("Register: unexpected err %+v", err)
+	}
+	for _, name := range []string{Gzip, Deflate} {
+		buf := &bytes.Buffer{}
+		w, err := Compress(name, buf)
+		if err != nil {
+			t.Fatalf("Compress(%s): unexpected err %+v", name, err)
+		}
+		w.Write([]byte("Hello, World!"))
+		w.Close()
+
+		r, err := Decompress(name, buf)
+		if err != nil {
+			t.Fatalf("Decompress(%s): unexpected err %+v", name, err)
+		}
+		if got, _ := ioutil.ReadAll(r); string(got) != "Hello, World!" {
+			t.Errorf("%s: want round trip, got %q", name, got)
+		}
+	}
+	if _, err := Decompress("br", &bytes.Buffer{}); err == nil {
+		t.Errorf("Decompress: want error for an unsupported compression")
+	}
+	if err := Register([]string{"br"}); err == nil {
+		t.Errorf("Register: want error for an unknown compressor")
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	if err := Register([]string{Gzip, Deflate}); err != nil {
+		t.Fatalf("Register: unexpected err %+v", err)
+	}
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"deflate, gzip", "gzip"},
+		{"gzip;q=0.5, deflate", "deflate"},
+		{"br, deflate;q=0.1", "deflate"},
+		{"*", "gzip"},
+		{"*;q=0.5, gzip;q=0", "deflate"},
+		{"GZIP ; q=1.0", "gzip"},
+	}
+	for _, test := range tests {
+		if got := NegotiateEncoding(test.acceptEncoding); got != test.want {
+			t.Errorf("NegotiateEncoding(%q): want %q, got %q", test.acceptEncoding, test.want, got)
+		}
+	}
+}
+
+func TestStatsHandler(t *testing.T) {
+	if err := Register([]string{Gzip}); err != nil {
+		t.Fatalf("Register: unexpected err %+v", err)
+	}
+	h := StatsHandler{}
+	ctx := h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/google.showcase.v1beta1.Echo/Echo"})
+	h.HandleRPC(ctx, &stats.InHeader{Compression: Gzip})
+	if req, resp := FromContext(ctx); req != Gzip || resp != Gzip {
+		t.Errorf("FromContext: want gzip both ways, got %q and %q", req, resp)
+	}
+
+	// Requests compressed with a compressor that is not enabled get
+	// uncompressed responses.
+	ctx = h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/google.showcase.v1beta1.Echo/Echo"})
+	h.HandleRPC(ctx, &stats.InHeader{Compression: "br"})
+	if req, resp := FromContext(ctx); req != "br" || resp != "" {
+		t.Errorf("FromContext: want a br request and an uncompressed response, got %q and %q", req, resp)
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	ctx := NewContext(context.Background(), "", Deflate)
+	if req, resp := FromContext(ctx); req != "" || resp != Deflate {
+		t.Errorf("NewContext: want an uncompressed request and a deflate response, got %q and %q", req, resp)
+	}
+	if req, resp := FromContext(context.Background()); req != "" || resp != "" {
+		t.Errorf("FromContext: want no compression by default, got %q and %q", req, resp)
+	}
+}
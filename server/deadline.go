@@ -0,0 +1,162 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// maxDeadlineRecords is the number of calls whose deadline a
+// DeadlineObserver remembers.
+const maxDeadlineRecords = 1000
+
+// DeadlineRecord describes the deadline with which a call was received.
+type DeadlineRecord struct {
+	CallID    uint64    `json:"call_id"`
+	Method    string    `json:"method"`
+	Transport string    `json:"transport"`
+	StartTime time.Time `json:"start_time"`
+
+	// Deadline and TimeoutSeconds, the time between StartTime and
+	// Deadline, are unset if the client did not set a deadline.
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	TimeoutSeconds *float64   `json:"timeout_seconds,omitempty"`
+
+	// Code is the status code of unary calls.
+	Code string `json:"code,omitempty"`
+}
+
+// DeadlineObserver is an observer that remembers the deadlines of the
+// most recent calls, so that tests can verify that clients propagate the
+// timeouts they are configured with. It serves them over HTTP as JSON.
+type DeadlineObserver struct {
+	mu      sync.Mutex
+	records []DeadlineRecord
+	streams map[uint64]bool // streams with a record in records
+}
+
+// NewDeadlineObserver returns a DeadlineObserver with no recorded calls.
+func NewDeadlineObserver() *DeadlineObserver {
+	return &DeadlineObserver{streams: map[uint64]bool{}}
+}
+
+// GetName returns the name under which the observer is registered.
+func (d *DeadlineObserver) GetName() string { return "deadlineObserver" }
+
+// ObserveUnary records the deadline of a unary call.
+func (d *DeadlineObserver) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	if record, ok := newDeadlineRecord(ctx, info.FullMethod); ok {
+		record.Code = status.Code(err).String()
+		d.add(record, false)
+	}
+}
+
+// ObserveStreamRequest records the deadline of a stream, once.
+func (d *DeadlineObserver) ObserveStreamRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if record, ok := newDeadlineRecord(ctx, info.FullMethod); ok {
+		d.add(record, true)
+	}
+}
+
+// ObserveStreamResponse records the deadline of a stream, once.
+func (d *DeadlineObserver) ObserveStreamResponse(
+	ctx context.Context,
+	resp interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if record, ok := newDeadlineRecord(ctx, info.FullMethod); ok {
+		d.add(record, true)
+	}
+}
+
+func newDeadlineRecord(ctx context.Context, method string) (DeadlineRecord, bool) {
+	call, ok := CallInfoFromContext(ctx)
+	if !ok {
+		return DeadlineRecord{}, false
+	}
+	record := DeadlineRecord{
+		CallID:    call.ID,
+		Method:    method,
+		Transport: call.Transport,
+		StartTime: call.Start,
+	}
+	if !call.Deadline.IsZero() {
+		deadline := call.Deadline
+		timeout := deadline.Sub(call.Start).Seconds()
+		record.Deadline = &deadline
+		record.TimeoutSeconds = &timeout
+	}
+	return record, true
+}
+
+func (d *DeadlineObserver) add(record DeadlineRecord, stream bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if stream {
+		if d.streams[record.CallID] {
+			return
+		}
+		d.streams[record.CallID] = true
+	}
+	if len(d.records) == maxDeadlineRecords {
+		delete(d.streams, d.records[0].CallID)
+		d.records = d.records[1:]
+	}
+	d.records = append(d.records, record)
+}
+
+// Records returns the recorded calls to the given method, from the
+// oldest to the most recent. The method is given either by its full
+// name (such as "/google.showcase.v1beta1.Identity/GetUser") or by its
+// name alone (such as "GetUser"). All the recorded calls are returned if
+// method is empty.
+func (d *DeadlineObserver) Records(method string) []DeadlineRecord {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	records := []DeadlineRecord{}
+	for _, record := range d.records {
+		if method == "" || record.Method == method || strings.HasSuffix(record.Method, "/"+method) {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// ServeHTTP writes the recorded calls as JSON. The calls can be
+// restricted to those of a method with the "method" query parameter,
+// as in Records.
+func (d *DeadlineObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Calls []DeadlineRecord `json:"calls"`
+	}{d.Records(r.URL.Query().Get("method"))})
+}
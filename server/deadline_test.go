@@ -0,0 +1,101 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeadlineObserver(t *testing.T) {
+	registry := ShowcaseObserverRegistry()
+	deadlines := NewDeadlineObserver()
+	registry.RegisterUnaryObserver(deadlines)
+	registry.RegisterStreamRequestObserver(deadlines)
+	registry.RegisterStreamResponseObserver(deadlines)
+
+	getUser := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Identity/GetUser"}
+	listBlurbs := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Messaging/ListBlurbs"}
+	ok := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	expired := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.DeadlineExceeded, "too late")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	registry.UnaryInterceptor(ctx, nil, getUser, ok)
+	registry.UnaryInterceptor(context.Background(), nil, getUser, expired)
+	registry.UnaryInterceptor(ctx, nil, listBlurbs, ok)
+
+	stream := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Messaging/StreamBlurbs"}
+	registry.StreamInterceptor(nil, &testServerStream{}, stream, func(_ interface{}, ss grpc.ServerStream) error {
+		ss.SendMsg("first")
+		ss.SendMsg("second")
+		return nil
+	})
+
+	records := deadlines.Records("GetUser")
+	if len(records) != 2 {
+		t.Fatalf("Records(GetUser) = %v, want 2 records", records)
+	}
+	if timeout := records[0].TimeoutSeconds; timeout == nil || *timeout <= 4 || *timeout > 5 {
+		t.Errorf("Records(GetUser)[0].TimeoutSeconds = %v, want about 5", timeout)
+	}
+	if records[0].Code != "OK" {
+		t.Errorf("Records(GetUser)[0].Code = %q, want OK", records[0].Code)
+	}
+	if records[1].Deadline != nil || records[1].TimeoutSeconds != nil || records[1].Code != "DeadlineExceeded" {
+		t.Errorf("Records(GetUser)[1] = %+v, want no deadline and a DeadlineExceeded code", records[1])
+	}
+	if got := deadlines.Records(listBlurbs.FullMethod); len(got) != 1 {
+		t.Errorf("Records(%s) = %v, want 1 record", listBlurbs.FullMethod, got)
+	}
+	if got := deadlines.Records("StreamBlurbs"); len(got) != 1 {
+		t.Errorf("Records(StreamBlurbs) = %v, want the stream recorded once", got)
+	}
+	if got := deadlines.Records(""); len(got) != 4 {
+		t.Errorf("Records() = %v, want 4 records", got)
+	}
+
+	w := httptest.NewRecorder()
+	deadlines.ServeHTTP(w, httptest.NewRequest("GET", "/deadlines?method=GetUser", nil))
+	var body struct {
+		Calls []DeadlineRecord `json:"calls"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || len(body.Calls) != 2 {
+		t.Errorf("ServeHTTP() wrote %s (%v), want the 2 GetUser calls", w.Body, err)
+	}
+}
+
+func TestDeadlineObserver_bounded(t *testing.T) {
+	deadlines := NewDeadlineObserver()
+	for i := 0; i < maxDeadlineRecords+10; i++ {
+		deadlines.add(DeadlineRecord{CallID: uint64(i)}, true)
+	}
+	records := deadlines.Records("")
+	if len(records) != maxDeadlineRecords || records[0].CallID != 10 {
+		t.Errorf("got %d records starting at call %d, want %d starting at call 10", len(records), records[0].CallID, maxDeadlineRecords)
+	}
+	if len(deadlines.streams) != maxDeadlineRecords {
+		t.Errorf("got %d streams, want %d", len(deadlines.streams), maxDeadlineRecords)
+	}
+}
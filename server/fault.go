@@ -0,0 +1,204 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys through which a client requests faults for a single call,
+// overriding the faults configured for the server. Over REST, they are
+// sent as HTTP headers.
+const (
+	// InjectStatusHeader holds the status code, by name (such as
+	// "UNAVAILABLE") or by number, with which the call fails. Unless
+	// InjectFailRateHeader is also set, the call always fails, or never
+	// fails if the code is OK.
+	InjectStatusHeader = "x-showcase-inject-status"
+
+	// InjectDelayHeader holds how long to wait before handling the call,
+	// such as "250ms" or "1.5s".
+	InjectDelayHeader = "x-showcase-inject-delay"
+
+	// InjectFailRateHeader holds the probability, in [0, 1], that the
+	// call fails.
+	InjectFailRateHeader = "x-showcase-inject-fail-rate"
+)
+
+// FaultConfig describes the faults to inject into RPCs.
+type FaultConfig struct {
+	// Delay is how long to wait before handling each call. It stands for
+	// the latency of the network, so it is waited in real time, even
+	// while the server clock is frozen or changed.
+	Delay time.Duration
+
+	// FailRate is the probability, in [0, 1], that a call fails
+	// instead of being handled.
+	FailRate float64
+
+	// Code is the status code of the injected failures. If it is
+	// codes.OK, codes.Unavailable is used.
+	Code codes.Code
+}
+
+// FaultInjector provides interceptors that inject faults into every
+// Showcase RPC, as configured for the server or as requested by the
+// client through the Inject*Header metadata. Calls to gRPC
+// infrastructure services, such as reflection, are left untouched.
+type FaultInjector struct {
+	defaults FaultConfig
+	randF    func() float64
+}
+
+// NewFaultInjector returns a FaultInjector that injects the faults
+// described by defaults.
+func NewFaultInjector(defaults FaultConfig) *FaultInjector {
+	return &FaultInjector{defaults: defaults, randF: rand.Float64}
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor.
+func (f *FaultInjector) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := f.inject(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor. Faults
+// are injected once, before the stream is handled.
+func (f *FaultInjector) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := f.inject(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// adminMethodPrefix is the prefix of the methods of the Admin service,
+// which manage the server rather than exercise it and are thus never
+// faulted nor replayed.
+const adminMethodPrefix = "/google.showcase.v1beta1.Admin/"
+
+func (f *FaultInjector) inject(ctx context.Context, method string) error {
+	if strings.HasPrefix(method, "/grpc.") || strings.HasPrefix(method, adminMethodPrefix) {
+		return nil
+	}
+	config, err := f.config(ctx)
+	if err != nil {
+		return err
+	}
+
+	if config.Delay > 0 {
+		if err := SleepRealTime(ctx, config.Delay); err != nil {
+			return err
+		}
+	}
+
+	if config.FailRate > 0 && f.randF() < config.FailRate {
+		code := config.Code
+		if code == codes.OK {
+			code = codes.Unavailable
+		}
+		return status.Errorf(code, "Fault injected into %s", method)
+	}
+	return nil
+}
+
+// config returns the faults to inject into the call with the given
+// context.
+func (f *FaultInjector) config(ctx context.Context) (FaultConfig, error) {
+	config := f.defaults
+	md, _ := metadata.FromIncomingContext(ctx)
+	invalid := func(key, value string, err error) error {
+		return status.Errorf(codes.InvalidArgument, "invalid %s header %q: %v", key, value, err)
+	}
+
+	if value, ok := lastValue(md, InjectDelayHeader); ok {
+		delay, err := time.ParseDuration(value)
+		if err == nil && delay < 0 {
+			err = fmt.Errorf("must not be negative")
+		}
+		if err != nil {
+			return config, invalid(InjectDelayHeader, value, err)
+		}
+		config.Delay = delay
+	}
+
+	if value, ok := lastValue(md, InjectStatusHeader); ok {
+		code, err := ParseCode(value)
+		if err != nil {
+			return config, invalid(InjectStatusHeader, value, err)
+		}
+		config.Code = code
+		config.FailRate = 1
+		if code == codes.OK {
+			config.FailRate = 0
+		}
+	}
+
+	if value, ok := lastValue(md, InjectFailRateHeader); ok {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err == nil && (rate < 0 || rate > 1) {
+			err = fmt.Errorf("must be within [0, 1]")
+		}
+		if err != nil {
+			return config, invalid(InjectFailRateHeader, value, err)
+		}
+		config.FailRate = rate
+	}
+	return config, nil
+}
+
+// lastValue returns the last value of key in md, if any.
+func lastValue(md metadata.MD, key string) (string, bool) {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.TrimSpace(values[len(values)-1]), true
+}
+
+// ParseCode parses a gRPC status code given either by name (such as
+// "UNAVAILABLE") or by number, which must be that of a valid code.
+func ParseCode(s string) (codes.Code, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < int(codes.OK) || n > int(codes.Unauthenticated) {
+			return codes.Unknown, fmt.Errorf("status code %d is out of range [%d, %d]", n, codes.OK, codes.Unauthenticated)
+		}
+		return codes.Code(n), nil
+	}
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(s)))); err != nil {
+		return codes.Unknown, fmt.Errorf("unknown status code %q", s)
+	}
+	return code, nil
+}
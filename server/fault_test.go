@@ -0,0 +1,149 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestFaultInjector_UnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   FaultConfig
+		method   string
+		roll     float64
+		wantCode codes.Code
+	}{
+		{"no faults", FaultConfig{}, "/google.showcase.v1beta1.Echo/Echo", 0, codes.OK},
+		{"roll above fail rate", FaultConfig{FailRate: 0.5}, "/google.showcase.v1beta1.Echo/Echo", 0.5, codes.OK},
+		{"roll below fail rate", FaultConfig{FailRate: 0.5}, "/google.showcase.v1beta1.Echo/Echo", 0.2, codes.Unavailable},
+		{"custom code", FaultConfig{FailRate: 1, Code: codes.Internal}, "/google.showcase.v1beta1.Identity/GetUser", 0.2, codes.Internal},
+		{"infrastructure method", FaultConfig{FailRate: 1}, "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", 0, codes.OK},
+		{"admin method", FaultConfig{FailRate: 1}, "/google.showcase.v1beta1.Admin/ResetState", 0, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFaultInjector(tt.config)
+			f.randF = func() float64 { return tt.roll }
+			called := false
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return "resp", nil
+			}
+			_, err := f.UnaryInterceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("UnaryInterceptor() code = %v, want %v", got, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("UnaryInterceptor() called handler = %t, want %t", called, !called)
+			}
+		})
+	}
+}
+
+func TestFaultInjector_delay(t *testing.T) {
+	f := NewFaultInjector(FaultConfig{Delay: 50 * time.Millisecond})
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+
+	start := time.Now()
+	if _, err := f.UnaryInterceptor(context.Background(), nil, info, handler); err != nil {
+		t.Fatalf("UnaryInterceptor() unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("UnaryInterceptor() returned after %s, want at least 50ms", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := f.UnaryInterceptor(ctx, nil, info, handler); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("UnaryInterceptor() with expiring context: got %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestFaultInjector_delayFollowsRealTime(t *testing.T) {
+	GetClockInstance().Freeze()
+	defer GetClockInstance().Reset()
+
+	f := NewFaultInjector(FaultConfig{Delay: 10 * time.Millisecond})
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := f.UnaryInterceptor(ctx, nil, info, handler); err != nil {
+		t.Errorf("UnaryInterceptor() with a frozen clock: got %v, want the delay to end", err)
+	}
+}
+
+func TestFaultInjector_headers(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   FaultConfig
+		md       metadata.MD
+		roll     float64
+		wantCode codes.Code
+	}{
+		{"status", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "UNAVAILABLE"), 0.9, codes.Unavailable},
+		{"status by number", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "14"), 0.9, codes.Unavailable},
+		{"lowercase status", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "deadline_exceeded"), 0.9, codes.DeadlineExceeded},
+		{"status with fail rate", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "ABORTED", InjectFailRateHeader, "0.5"), 0.9, codes.OK},
+		{"fail rate", FaultConfig{}, metadata.Pairs(InjectFailRateHeader, "0.5"), 0.2, codes.Unavailable},
+		{"fail rate overrides server", FaultConfig{FailRate: 1}, metadata.Pairs(InjectFailRateHeader, "0"), 0.2, codes.OK},
+		{"OK status overrides server", FaultConfig{FailRate: 1}, metadata.Pairs(InjectStatusHeader, "OK"), 0.2, codes.OK},
+		{"last value wins", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "INTERNAL", InjectStatusHeader, "NOT_FOUND"), 0.2, codes.NotFound},
+		{"invalid status", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "BROKEN"), 0.2, codes.InvalidArgument},
+		{"status out of range", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "99"), 0.2, codes.InvalidArgument},
+		{"negative status", FaultConfig{}, metadata.Pairs(InjectStatusHeader, "-1"), 0.2, codes.InvalidArgument},
+		{"invalid fail rate", FaultConfig{}, metadata.Pairs(InjectFailRateHeader, "2"), 0.2, codes.InvalidArgument},
+		{"invalid delay", FaultConfig{}, metadata.Pairs(InjectDelayHeader, "soon"), 0.2, codes.InvalidArgument},
+		{"negative delay", FaultConfig{}, metadata.Pairs(InjectDelayHeader, "-1s"), 0.2, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFaultInjector(tt.config)
+			f.randF = func() float64 { return tt.roll }
+			handler := func(context.Context, interface{}) (interface{}, error) { return "resp", nil }
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Identity/GetUser"}
+			_, err := f.UnaryInterceptor(ctx, "req", info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("UnaryInterceptor() = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestFaultInjector_delayHeader(t *testing.T) {
+	f := NewFaultInjector(FaultConfig{})
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Messaging/ListBlurbs"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(InjectDelayHeader, "50ms"))
+
+	start := time.Now()
+	if _, err := f.UnaryInterceptor(ctx, nil, info, handler); err != nil {
+		t.Fatalf("UnaryInterceptor() unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("UnaryInterceptor() returned after %s, want at least 50ms", elapsed)
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.1
+// source: google/showcase/v1beta1/seed.proto
+
+package genproto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// The resources to create when the Showcase server starts, as read from the
+// JSON or text format file given to `gapic-showcase run --seed`. They are
+// created through the regular Create methods, users first and sequences last,
+// so that they are validated and named as if a client had created them.
+//
+// Since the names of the created resources are assigned by the server, the
+// parent and the user of a blurb may refer to a seeded user or room through
+// the placeholder `${alias}`, which is replaced by the name of the resource
+// declared with that alias. For example, a blurb in the profile of the user
+// with the alias `alice` has the parent `${alice}/profile`.
+type Seed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The users to create.
+	Users []*Seed_SeededUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// The rooms to create.
+	Rooms []*Seed_SeededRoom `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// The blurbs to create.
+	Blurbs []*Seed_SeededBlurb `protobuf:"bytes,3,rep,name=blurbs,proto3" json:"blurbs,omitempty"`
+	// The sequences to create.
+	Sequences []*Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+}
+
+func (x *Seed) Reset() {
+	*x = Seed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seed) ProtoMessage() {}
+
+func (x *Seed) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seed.ProtoReflect.Descriptor instead.
+func (*Seed) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_seed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Seed) GetUsers() []*Seed_SeededUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Seed) GetRooms() []*Seed_SeededRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *Seed) GetBlurbs() []*Seed_SeededBlurb {
+	if x != nil {
+		return x.Blurbs
+	}
+	return nil
+}
+
+func (x *Seed) GetSequences() []*Sequence {
+	if x != nil {
+		return x.Sequences
+	}
+	return nil
+}
+
+// A user to create.
+type Seed_SeededUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The alias by which blurbs refer to the user. Optional.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The user to create.
+	User *User `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Seed_SeededUser) Reset() {
+	*x = Seed_SeededUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seed_SeededUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seed_SeededUser) ProtoMessage() {}
+
+func (x *Seed_SeededUser) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seed_SeededUser.ProtoReflect.Descriptor instead.
+func (*Seed_SeededUser) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_seed_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Seed_SeededUser) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Seed_SeededUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// A room to create.
+type Seed_SeededRoom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The alias by which blurbs refer to the room. Optional.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The room to create.
+	Room *Room `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *Seed_SeededRoom) Reset() {
+	*x = Seed_SeededRoom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seed_SeededRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seed_SeededRoom) ProtoMessage() {}
+
+func (x *Seed_SeededRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seed_SeededRoom.ProtoReflect.Descriptor instead.
+func (*Seed_SeededRoom) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_seed_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Seed_SeededRoom) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Seed_SeededRoom) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+// A blurb to create.
+type Seed_SeededBlurb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the room or user profile in which to create the
+	// blurb.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The blurb to create.
+	Blurb *Blurb `protobuf:"bytes,2,opt,name=blurb,proto3" json:"blurb,omitempty"`
+}
+
+func (x *Seed_SeededBlurb) Reset() {
+	*x = Seed_SeededBlurb{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seed_SeededBlurb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seed_SeededBlurb) ProtoMessage() {}
+
+func (x *Seed_SeededBlurb) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_seed_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seed_SeededBlurb.ProtoReflect.Descriptor instead.
+func (*Seed_SeededBlurb) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_seed_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Seed_SeededBlurb) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *Seed_SeededBlurb) GetBlurb() *Blurb {
+	if x != nil {
+		return x.Blurb
+	}
+	return nil
+}
+
+var File_google_showcase_v1beta1_seed_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_seed_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x65, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x26, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x04, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12,
+	0x3e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x3e, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x53, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
+	0x41, 0x0a, 0x06, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x06, 0x62, 0x6c, 0x75, 0x72,
+	0x62, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x1a, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x65, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x55, 0x0a, 0x0a, 0x53, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x31,
+	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x1a, 0x5b, 0x0a, 0x0b, 0x53, 0x65, 0x65, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x75, 0x72, 0x62,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x62, 0x6c, 0x75, 0x72,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x05, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x42, 0x71,
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a,
+	0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_seed_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_seed_proto_rawDescData = file_google_showcase_v1beta1_seed_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_seed_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_seed_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_seed_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_seed_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_seed_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_seed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_google_showcase_v1beta1_seed_proto_goTypes = []interface{}{
+	(*Seed)(nil),             // 0: google.showcase.v1beta1.Seed
+	(*Seed_SeededUser)(nil),  // 1: google.showcase.v1beta1.Seed.SeededUser
+	(*Seed_SeededRoom)(nil),  // 2: google.showcase.v1beta1.Seed.SeededRoom
+	(*Seed_SeededBlurb)(nil), // 3: google.showcase.v1beta1.Seed.SeededBlurb
+	(*Sequence)(nil),         // 4: google.showcase.v1beta1.Sequence
+	(*User)(nil),             // 5: google.showcase.v1beta1.User
+	(*Room)(nil),             // 6: google.showcase.v1beta1.Room
+	(*Blurb)(nil),            // 7: google.showcase.v1beta1.Blurb
+}
+var file_google_showcase_v1beta1_seed_proto_depIdxs = []int32{
+	1, // 0: google.showcase.v1beta1.Seed.users:type_name -> google.showcase.v1beta1.Seed.SeededUser
+	2, // 1: google.showcase.v1beta1.Seed.rooms:type_name -> google.showcase.v1beta1.Seed.SeededRoom
+	3, // 2: google.showcase.v1beta1.Seed.blurbs:type_name -> google.showcase.v1beta1.Seed.SeededBlurb
+	4, // 3: google.showcase.v1beta1.Seed.sequences:type_name -> google.showcase.v1beta1.Sequence
+	5, // 4: google.showcase.v1beta1.Seed.SeededUser.user:type_name -> google.showcase.v1beta1.User
+	6, // 5: google.showcase.v1beta1.Seed.SeededRoom.room:type_name -> google.showcase.v1beta1.Room
+	7, // 6: google.showcase.v1beta1.Seed.SeededBlurb.blurb:type_name -> google.showcase.v1beta1.Blurb
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_seed_proto_init() }
+func file_google_showcase_v1beta1_seed_proto_init() {
+	if File_google_showcase_v1beta1_seed_proto != nil {
+		return
+	}
+	file_google_showcase_v1beta1_identity_proto_init()
+	file_google_showcase_v1beta1_messaging_proto_init()
+	file_google_showcase_v1beta1_sequence_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_seed_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_seed_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seed_SeededUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_seed_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seed_SeededRoom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_seed_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seed_SeededBlurb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_seed_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_google_showcase_v1beta1_seed_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_seed_proto_depIdxs,
+		MessageInfos:      file_google_showcase_v1beta1_seed_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_seed_proto = out.File
+	file_google_showcase_v1beta1_seed_proto_rawDesc = nil
+	file_google_showcase_v1beta1_seed_proto_goTypes = nil
+	file_google_showcase_v1beta1_seed_proto_depIdxs = nil
+}
@@ -64,10 +64,12 @@ func (backend *RESTBackend) HandleEcho(w http.ResponseWriter, r *http.Request) {
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Echo(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.EchoServer, "/google.showcase.v1beta1.Echo/Echo", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.EchoServer.Echo(ctx, req.(*genprotopb.EchoRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -132,10 +134,12 @@ func (backend *RESTBackend) HandlePagedExpand(w http.ResponseWriter, r *http.Req
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.PagedExpand(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.EchoServer, "/google.showcase.v1beta1.Echo/PagedExpand", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.EchoServer.PagedExpand(ctx, req.(*genprotopb.PagedExpandRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -184,10 +188,12 @@ func (backend *RESTBackend) HandleWait(w http.ResponseWriter, r *http.Request) {
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Wait(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.EchoServer, "/google.showcase.v1beta1.Echo/Wait", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.EchoServer.Wait(ctx, req.(*genprotopb.WaitRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -236,10 +242,12 @@ func (backend *RESTBackend) HandleBlock(w http.ResponseWriter, r *http.Request)
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Block(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.EchoServer, "/google.showcase.v1beta1.Echo/Block", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.EchoServer.Block(ctx, req.(*genprotopb.BlockRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -19,6 +19,7 @@ package genrest
 
 import (
 	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 
 	gmux "github.com/gorilla/mux"
 )
@@ -27,47 +28,56 @@ type RESTBackend services.Backend
 
 func RegisterHandlers(router *gmux.Router, backend *services.Backend) {
 	rest := (*RESTBackend)(backend)
-	router.HandleFunc("/v1beta1/echo:echo", rest.HandleEcho).Methods("POST")
-	router.HandleFunc("/v1beta1/echo:expand", rest.HandleExpand).Methods("POST")
-	router.HandleFunc("/v1beta1/echo:collect", rest.HandleCollect).Methods("POST")
-	router.HandleFunc("/v1beta1/echo:pagedExpand", rest.HandlePagedExpand).Methods("POST")
-	router.HandleFunc("/v1beta1/echo:wait", rest.HandleWait).Methods("POST")
-	router.HandleFunc("/v1beta1/echo:block", rest.HandleBlock).Methods("POST")
-	router.HandleFunc("/v1beta1/users", rest.HandleCreateUser).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+}", rest.HandleGetUser).Methods("GET")
-	router.HandleFunc("/v1beta1/{user.name:users/[0-9a-zA-Z_%\\-]+}", rest.HandleUpdateUser).Methods("PATCH")
-	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteUser).Methods("DELETE")
-	router.HandleFunc("/v1beta1/users", rest.HandleListUsers).Methods("GET")
-	router.HandleFunc("/v1beta1/rooms", rest.HandleCreateRoom).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}", rest.HandleGetRoom).Methods("GET")
-	router.HandleFunc("/v1beta1/{room.name:rooms/[0-9a-zA-Z_%\\-]+}", rest.HandleUpdateRoom).Methods("PATCH")
-	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteRoom).Methods("DELETE")
-	router.HandleFunc("/v1beta1/rooms", rest.HandleListRooms).Methods("GET")
-	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs", rest.HandleCreateBlurb).Methods("POST")
-	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs", rest.HandleCreateBlurb_1).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleGetBlurb).Methods("GET")
-	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleGetBlurb_1).Methods("GET")
-	router.HandleFunc("/v1beta1/{blurb.name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleUpdateBlurb).Methods("PATCH")
-	router.HandleFunc("/v1beta1/{blurb.name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleUpdateBlurb_1).Methods("PATCH")
-	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteBlurb).Methods("DELETE")
-	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteBlurb_1).Methods("DELETE")
-	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs", rest.HandleListBlurbs).Methods("GET")
-	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs", rest.HandleListBlurbs_1).Methods("GET")
-	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:search", rest.HandleSearchBlurbs).Methods("POST")
-	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:search", rest.HandleSearchBlurbs_1).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:stream", rest.HandleStreamBlurbs).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:stream", rest.HandleStreamBlurbs_1).Methods("POST")
-	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:send", rest.HandleSendBlurbs).Methods("POST")
-	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:send", rest.HandleSendBlurbs_1).Methods("POST")
-	router.HandleFunc("/v1beta1/sequences", rest.HandleCreateSequence).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:sequences/[0-9a-zA-Z_%\\-]+/sequenceReport}", rest.HandleGetSequenceReport).Methods("GET")
-	router.HandleFunc("/v1beta1/{name:sequences/[0-9a-zA-Z_%\\-]+}", rest.HandleAttemptSequence).Methods("POST")
-	router.HandleFunc("/v1beta1/sessions", rest.HandleCreateSession).Methods("POST")
-	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}", rest.HandleGetSession).Methods("GET")
-	router.HandleFunc("/v1beta1/sessions", rest.HandleListSessions).Methods("GET")
-	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteSession).Methods("DELETE")
-	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}:report", rest.HandleReportSession).Methods("POST")
-	router.HandleFunc("/v1beta1/{parent:sessions/[0-9a-zA-Z_%\\-]+}/tests", rest.HandleListTests).Methods("GET")
-	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+/tests/[0-9a-zA-Z_%\\-]+}", rest.HandleDeleteTest).Methods("DELETE")
-	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+/tests/[0-9a-zA-Z_%\\-]+}:check", rest.HandleVerifyTest).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:echo", resttools.WithCompression(rest.HandleEcho)).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:expand", resttools.WithCompression(rest.HandleExpand)).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:collect", resttools.WithCompression(rest.HandleCollect)).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:pagedExpand", resttools.WithCompression(rest.HandlePagedExpand)).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:wait", resttools.WithCompression(rest.HandleWait)).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:block", resttools.WithCompression(rest.HandleBlock)).Methods("POST")
+	router.HandleFunc("/v1beta1/users", resttools.WithCompression(rest.HandleCreateUser)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleGetUser)).Methods("GET")
+	router.HandleFunc("/v1beta1/{user.name:users/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleUpdateUser)).Methods("PATCH")
+	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteUser)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/users", resttools.WithCompression(rest.HandleListUsers)).Methods("GET")
+	router.HandleFunc("/v1beta1/rooms", resttools.WithCompression(rest.HandleCreateRoom)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleGetRoom)).Methods("GET")
+	router.HandleFunc("/v1beta1/{room.name:rooms/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleUpdateRoom)).Methods("PATCH")
+	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteRoom)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/rooms", resttools.WithCompression(rest.HandleListRooms)).Methods("GET")
+	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs", resttools.WithCompression(rest.HandleCreateBlurb)).Methods("POST")
+	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs", resttools.WithCompression(rest.HandleCreateBlurb_1)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleGetBlurb)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleGetBlurb_1)).Methods("GET")
+	router.HandleFunc("/v1beta1/{blurb.name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleUpdateBlurb)).Methods("PATCH")
+	router.HandleFunc("/v1beta1/{blurb.name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleUpdateBlurb_1)).Methods("PATCH")
+	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteBlurb)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile/blurbs/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteBlurb_1)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs", resttools.WithCompression(rest.HandleListBlurbs)).Methods("GET")
+	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs", resttools.WithCompression(rest.HandleListBlurbs_1)).Methods("GET")
+	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:search", resttools.WithCompression(rest.HandleSearchBlurbs)).Methods("POST")
+	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:search", resttools.WithCompression(rest.HandleSearchBlurbs_1)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:stream", resttools.WithCompression(rest.HandleStreamBlurbs)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:stream", resttools.WithCompression(rest.HandleStreamBlurbs_1)).Methods("POST")
+	router.HandleFunc("/v1beta1/{parent:rooms/[0-9a-zA-Z_%\\-]+}/blurbs:send", resttools.WithCompression(rest.HandleSendBlurbs)).Methods("POST")
+	router.HandleFunc("/v1beta1/{parent:users/[0-9a-zA-Z_%\\-]+/profile}/blurbs:send", resttools.WithCompression(rest.HandleSendBlurbs_1)).Methods("POST")
+	router.HandleFunc("/v1beta1/sequences", resttools.WithCompression(rest.HandleCreateSequence)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:sequences/[0-9a-zA-Z_%\\-]+/sequenceReport}", resttools.WithCompression(rest.HandleGetSequenceReport)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:sequences/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleAttemptSequence)).Methods("POST")
+	router.HandleFunc("/v1beta1/sessions", resttools.WithCompression(rest.HandleCreateSession)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleGetSession)).Methods("GET")
+	router.HandleFunc("/v1beta1/sessions", resttools.WithCompression(rest.HandleListSessions)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteSession)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+}:report", resttools.WithCompression(rest.HandleReportSession)).Methods("POST")
+	router.HandleFunc("/v1beta1/{parent:sessions/[0-9a-zA-Z_%\\-]+}/tests", resttools.WithCompression(rest.HandleListTests)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+/tests/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteTest)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/{name:sessions/[0-9a-zA-Z_%\\-]+/tests/[0-9a-zA-Z_%\\-]+}:check", resttools.WithCompression(rest.HandleVerifyTest)).Methods("POST")
+	router.HandleFunc("/v1beta1/admin:resetState", resttools.WithCompression(rest.HandleResetState)).Methods("POST")
+	router.HandleFunc("/v1beta1/admin:dumpState", resttools.WithCompression(rest.HandleDumpState)).Methods("GET")
+	router.HandleFunc("/v1beta1/admin:stats", resttools.WithCompression(rest.HandleGetStats)).Methods("GET")
+	router.HandleFunc("/v1beta1/admin/tenants", resttools.WithCompression(rest.HandleListTenants)).Methods("GET")
+	router.HandleFunc("/v1beta1/admin/{name:tenants/[0-9a-zA-Z_%\\-]+}", resttools.WithCompression(rest.HandleDeleteTenant)).Methods("DELETE")
+	router.HandleFunc("/v1beta1/admin/clock", resttools.WithCompression(rest.HandleGetClock)).Methods("GET")
+	router.HandleFunc("/v1beta1/admin/clock:update", resttools.WithCompression(rest.HandleUpdateClock)).Methods("POST")
+	router.HandleFunc("/v1beta1/admin:issueToken", resttools.WithCompression(rest.HandleIssueToken)).Methods("POST")
+	router.HandleFunc("/v1beta1/admin/health:setServingStatus", resttools.WithCompression(rest.HandleSetServingStatus)).Methods("POST")
 }
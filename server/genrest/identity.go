@@ -64,10 +64,12 @@ func (backend *RESTBackend) HandleCreateUser(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.CreateUser(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.IdentityServer, "/google.showcase.v1beta1.Identity/CreateUser", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.IdentityServer.CreateUser(ctx, req.(*genprotopb.CreateUserRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -119,10 +121,12 @@ func (backend *RESTBackend) HandleGetUser(w http.ResponseWriter, r *http.Request
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.GetUser(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.IdentityServer, "/google.showcase.v1beta1.Identity/GetUser", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.IdentityServer.GetUser(ctx, req.(*genprotopb.GetUserRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -171,10 +175,12 @@ func (backend *RESTBackend) HandleUpdateUser(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.UpdateUser(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.IdentityServer, "/google.showcase.v1beta1.Identity/UpdateUser", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.IdentityServer.UpdateUser(ctx, req.(*genprotopb.UpdateUserRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -226,10 +232,12 @@ func (backend *RESTBackend) HandleDeleteUser(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.DeleteUser(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.IdentityServer, "/google.showcase.v1beta1.Identity/DeleteUser", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.IdentityServer.DeleteUser(ctx, req.(*genprotopb.DeleteUserRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -281,10 +289,12 @@ func (backend *RESTBackend) HandleListUsers(w http.ResponseWriter, r *http.Reque
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.ListUsers(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.IdentityServer, "/google.showcase.v1beta1.Identity/ListUsers", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.IdentityServer.ListUsers(ctx, req.(*genprotopb.ListUsersRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -64,10 +64,12 @@ func (backend *RESTBackend) HandleCreateRoom(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateRoom(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/CreateRoom", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.CreateRoom(ctx, req.(*genprotopb.CreateRoomRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -119,10 +121,12 @@ func (backend *RESTBackend) HandleGetRoom(w http.ResponseWriter, r *http.Request
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetRoom(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/GetRoom", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.GetRoom(ctx, req.(*genprotopb.GetRoomRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -171,10 +175,12 @@ func (backend *RESTBackend) HandleUpdateRoom(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateRoom(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/UpdateRoom", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.UpdateRoom(ctx, req.(*genprotopb.UpdateRoomRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -226,10 +232,12 @@ func (backend *RESTBackend) HandleDeleteRoom(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteRoom(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/DeleteRoom", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.DeleteRoom(ctx, req.(*genprotopb.DeleteRoomRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -281,10 +289,12 @@ func (backend *RESTBackend) HandleListRooms(w http.ResponseWriter, r *http.Reque
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListRooms(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/ListRooms", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.ListRooms(ctx, req.(*genprotopb.ListRoomsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -333,10 +343,12 @@ func (backend *RESTBackend) HandleCreateBlurb(w http.ResponseWriter, r *http.Req
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/CreateBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.CreateBlurb(ctx, req.(*genprotopb.CreateBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -385,10 +397,12 @@ func (backend *RESTBackend) HandleCreateBlurb_1(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/CreateBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.CreateBlurb(ctx, req.(*genprotopb.CreateBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -440,10 +454,12 @@ func (backend *RESTBackend) HandleGetBlurb(w http.ResponseWriter, r *http.Reques
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/GetBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.GetBlurb(ctx, req.(*genprotopb.GetBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -495,10 +511,12 @@ func (backend *RESTBackend) HandleGetBlurb_1(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/GetBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.GetBlurb(ctx, req.(*genprotopb.GetBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -547,10 +565,12 @@ func (backend *RESTBackend) HandleUpdateBlurb(w http.ResponseWriter, r *http.Req
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/UpdateBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.UpdateBlurb(ctx, req.(*genprotopb.UpdateBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -599,10 +619,12 @@ func (backend *RESTBackend) HandleUpdateBlurb_1(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/UpdateBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.UpdateBlurb(ctx, req.(*genprotopb.UpdateBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -654,10 +676,12 @@ func (backend *RESTBackend) HandleDeleteBlurb(w http.ResponseWriter, r *http.Req
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/DeleteBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.DeleteBlurb(ctx, req.(*genprotopb.DeleteBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -709,10 +733,12 @@ func (backend *RESTBackend) HandleDeleteBlurb_1(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteBlurb(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/DeleteBlurb", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.DeleteBlurb(ctx, req.(*genprotopb.DeleteBlurbRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -764,10 +790,12 @@ func (backend *RESTBackend) HandleListBlurbs(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListBlurbs(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/ListBlurbs", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.ListBlurbs(ctx, req.(*genprotopb.ListBlurbsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -819,10 +847,12 @@ func (backend *RESTBackend) HandleListBlurbs_1(w http.ResponseWriter, r *http.Re
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListBlurbs(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/ListBlurbs", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.ListBlurbs(ctx, req.(*genprotopb.ListBlurbsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -871,10 +901,12 @@ func (backend *RESTBackend) HandleSearchBlurbs(w http.ResponseWriter, r *http.Re
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.SearchBlurbs(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/SearchBlurbs", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.SearchBlurbs(ctx, req.(*genprotopb.SearchBlurbsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -926,10 +958,12 @@ func (backend *RESTBackend) HandleSearchBlurbs_1(w http.ResponseWriter, r *http.
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.SearchBlurbs(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.MessagingServer, "/google.showcase.v1beta1.Messaging/SearchBlurbs", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.MessagingServer.SearchBlurbs(ctx, req.(*genprotopb.SearchBlurbsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
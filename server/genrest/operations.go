@@ -0,0 +1,150 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is written by hand: google.longrunning.Operations is not one of
+// the Showcase protos from which the other handlers are generated.
+
+package genrest
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	gmux "github.com/gorilla/mux"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterOperationsHandlers registers the REST handlers of the
+// google.longrunning.Operations service. They follow the HTTP bindings of
+// google/longrunning/operations.proto under the /v1beta1 prefix of the
+// Showcase services, with WaitOperation bound to ":wait". As in the
+// generated handlers, the name matches "**" up to the verb, if any.
+func RegisterOperationsHandlers(router *gmux.Router, backend *services.Backend) {
+	rest := (*RESTBackend)(backend)
+	router.HandleFunc("/v1beta1/operations", resttools.WithCompression(rest.HandleListOperations)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:operations/[0-9a-zA-Z_%\\-/]+}:cancel", resttools.WithCompression(rest.HandleCancelOperation)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:operations/[0-9a-zA-Z_%\\-/]+}:wait", resttools.WithCompression(rest.HandleWaitOperation)).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:operations/[0-9a-zA-Z_%\\-/]+}", resttools.WithCompression(rest.HandleGetOperation)).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:operations/[0-9a-zA-Z_%\\-/]+}", resttools.WithCompression(rest.HandleDeleteOperation)).Methods("DELETE")
+}
+
+// HandleListOperations serves ListOperations at GET /v1beta1/operations,
+// taking the other fields of the request from the query parameters.
+func (backend *RESTBackend) HandleListOperations(w http.ResponseWriter, r *http.Request) {
+	request := &lropb.ListOperationsRequest{}
+	if !backend.readOperationsRequest(w, r, request, false) {
+		return
+	}
+	request.Name = "operations"
+	backend.invokeOperations(w, r, "ListOperations", request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return backend.OperationsServer.ListOperations(ctx, req.(*lropb.ListOperationsRequest))
+	})
+}
+
+// HandleGetOperation serves GetOperation at GET /v1beta1/{name=operations/**}.
+func (backend *RESTBackend) HandleGetOperation(w http.ResponseWriter, r *http.Request) {
+	request := &lropb.GetOperationRequest{}
+	if !backend.readOperationsRequest(w, r, request, false) {
+		return
+	}
+	request.Name = gmux.Vars(r)["name"]
+	backend.invokeOperations(w, r, "GetOperation", request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return backend.OperationsServer.GetOperation(ctx, req.(*lropb.GetOperationRequest))
+	})
+}
+
+// HandleDeleteOperation serves DeleteOperation at DELETE /v1beta1/{name=operations/**}.
+func (backend *RESTBackend) HandleDeleteOperation(w http.ResponseWriter, r *http.Request) {
+	request := &lropb.DeleteOperationRequest{}
+	if !backend.readOperationsRequest(w, r, request, false) {
+		return
+	}
+	request.Name = gmux.Vars(r)["name"]
+	backend.invokeOperations(w, r, "DeleteOperation", request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return backend.OperationsServer.DeleteOperation(ctx, req.(*lropb.DeleteOperationRequest))
+	})
+}
+
+// HandleCancelOperation serves CancelOperation at POST /v1beta1/{name=operations/**}:cancel.
+func (backend *RESTBackend) HandleCancelOperation(w http.ResponseWriter, r *http.Request) {
+	request := &lropb.CancelOperationRequest{}
+	if !backend.readOperationsRequest(w, r, request, true) {
+		return
+	}
+	request.Name = gmux.Vars(r)["name"]
+	backend.invokeOperations(w, r, "CancelOperation", request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return backend.OperationsServer.CancelOperation(ctx, req.(*lropb.CancelOperationRequest))
+	})
+}
+
+// HandleWaitOperation serves WaitOperation at POST /v1beta1/{name=operations/**}:wait,
+// taking the timeout from the body.
+func (backend *RESTBackend) HandleWaitOperation(w http.ResponseWriter, r *http.Request) {
+	request := &lropb.WaitOperationRequest{}
+	if !backend.readOperationsRequest(w, r, request, true) {
+		return
+	}
+	request.Name = gmux.Vars(r)["name"]
+	backend.invokeOperations(w, r, "WaitOperation", request, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return backend.OperationsServer.WaitOperation(ctx, req.(*lropb.WaitOperationRequest))
+	})
+}
+
+// readOperationsRequest populates request from the query parameters of r
+// and, if withBody, from its JSON body, which may be empty. It writes an
+// INVALID_ARGUMENT error response and returns false if either is invalid.
+func (backend *RESTBackend) readOperationsRequest(w http.ResponseWriter, r *http.Request, request proto.Message, withBody bool) bool {
+	backend.StdLog.Printf("Received %s request for %q", r.Method, r.URL)
+	if withBody {
+		if err := jsonpb.Unmarshal(r.Body, request); err != nil && err != io.EOF {
+			backend.StdLog.Printf("  error reading body: %s", err)
+			resttools.ErrorResponse(w, status.Errorf(codes.InvalidArgument, "invalid request body: %s", err))
+			return false
+		}
+	}
+	if err := resttools.PopulateFields(proto.MessageV2(request), map[string][]string(r.URL.Query())); err != nil {
+		backend.StdLog.Printf("  error reading query params: %s", err)
+		resttools.ErrorResponse(w, status.Errorf(codes.InvalidArgument, "invalid query parameters: %s", err))
+		return false
+	}
+	return true
+}
+
+// invokeOperations calls the named method of the Operations service
+// through the interceptors of the backend, writing its response as JSON.
+func (backend *RESTBackend) invokeOperations(w http.ResponseWriter, r *http.Request, method string, request proto.Message, handler func(ctx context.Context, req interface{}) (interface{}, error)) {
+	marshaler := &jsonpb.Marshaler{}
+	requestJSON, _ := marshaler.MarshalToString(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.OperationsServer, "/google.longrunning.Operations/"+method, request, handler)
+	if err != nil {
+		resttools.ErrorResponse(w, err)
+		return
+	}
+
+	json, err := marshaler.MarshalToString(response)
+	if err != nil {
+		resttools.ErrorResponse(w, status.Errorf(codes.Internal, "could not marshal the response: %s", err))
+		return
+	}
+	w.Write([]byte(json))
+}
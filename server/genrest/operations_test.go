@@ -0,0 +1,222 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genrest
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	gmux "github.com/gorilla/mux"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+)
+
+// newOperationsRouter returns a router serving the Operations handlers of
+// a fresh backend, along with that backend.
+func newOperationsRouter() (*gmux.Router, *services.Backend) {
+	tenants := services.NewTenants(server.ShowcaseObserverRegistry())
+	backend := &services.Backend{
+		EchoServer:       tenants.EchoServer(),
+		OperationsServer: tenants.OperationsServer(),
+		Tenants:          tenants,
+		StdLog:           log.New(ioutil.Discard, "", 0),
+		ErrLog:           log.New(ioutil.Discard, "", 0),
+	}
+	router := gmux.NewRouter()
+	RegisterOperationsHandlers(router, backend)
+	return router, backend
+}
+
+// startWait starts an Echo Wait operation lasting ttl and returns its name.
+func startWait(t *testing.T, backend *services.Backend, ttl time.Duration) string {
+	t.Helper()
+	op, err := backend.EchoServer.Wait(context.Background(), &pb.WaitRequest{
+		End:      &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(ttl)},
+		Response: &pb.WaitRequest_Success{Success: &pb.WaitResponse{Content: "done"}},
+	})
+	if err != nil {
+		t.Fatalf("Wait: unexpected err %+v", err)
+	}
+	return op.GetName()
+}
+
+// serve sends a request with the given method, path and body to router,
+// and returns the response.
+func serve(router http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return w
+}
+
+// operation returns the operation in the body of w, failing t unless w
+// is a successful response.
+func operation(t *testing.T, w *httptest.ResponseRecorder) *lropb.Operation {
+	t.Helper()
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	op := &lropb.Operation{}
+	if err := jsonpb.UnmarshalString(w.Body.String(), op); err != nil {
+		t.Fatalf("could not unmarshal %q: %v", w.Body, err)
+	}
+	return op
+}
+
+func TestOperationsHandlers_list(t *testing.T) {
+	router, backend := newOperationsRouter()
+	var pending []string
+	for i := 0; i < 3; i++ {
+		pending = append(pending, startWait(t, backend, time.Hour))
+	}
+	done := startWait(t, backend, 0)
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"", append(append([]string{}, pending...), done)},
+		{"done = true", []string{done}},
+		{"done = false", pending},
+	}
+	for _, test := range tests {
+		got := []string{}
+		token := ""
+		for {
+			query := url.Values{"filter": {test.filter}, "page_size": {"2"}, "page_token": {token}}
+			w := serve(router, http.MethodGet, "/v1beta1/operations?"+query.Encode(), "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("list %q: got status %d, want %d: %s", test.filter, w.Code, http.StatusOK, w.Body)
+			}
+			res := &lropb.ListOperationsResponse{}
+			if err := jsonpb.UnmarshalString(w.Body.String(), res); err != nil {
+				t.Fatalf("list %q: could not unmarshal %q: %v", test.filter, w.Body, err)
+			}
+			if len(res.GetOperations()) > 2 {
+				t.Errorf("list %q: want at most 2 operations per page, got %d", test.filter, len(res.GetOperations()))
+			}
+			for _, op := range res.GetOperations() {
+				got = append(got, op.GetName())
+			}
+			if token = res.GetNextPageToken(); token == "" {
+				break
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("list %q: want %v, got %v", test.filter, test.want, got)
+		}
+	}
+
+	if w := serve(router, http.MethodGet, "/v1beta1/operations?page_token=bad", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("list with an invalid page token: got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestOperationsHandlers_get(t *testing.T) {
+	router, backend := newOperationsRouter()
+	name := startWait(t, backend, 0)
+
+	op := operation(t, serve(router, http.MethodGet, "/v1beta1/"+name, ""))
+	if op.GetName() != name || !op.GetDone() {
+		t.Errorf("get: want the finished operation %q, got %+v", name, op)
+	}
+	resp := &pb.WaitResponse{}
+	if err := ptypes.UnmarshalAny(op.GetResponse(), resp); err != nil || resp.GetContent() != "done" {
+		t.Errorf("get: want the response of the wait, got %+v, %v", op.GetResponse(), err)
+	}
+
+	if w := serve(router, http.MethodGet, "/v1beta1/operations/missing", ""); w.Code != http.StatusNotFound {
+		t.Errorf("get a missing operation: got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestOperationsHandlers_verbs(t *testing.T) {
+	router, backend := newOperationsRouter()
+	name := startWait(t, backend, time.Hour)
+
+	// The names of the GET and DELETE routes do not swallow a verb.
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		for _, verb := range []string{":cancel", ":wait"} {
+			if w := serve(router, method, "/v1beta1/"+name+verb, ""); w.Code != http.StatusMethodNotAllowed {
+				t.Errorf("%s %s: got status %d, want %d", method, verb, w.Code, http.StatusMethodNotAllowed)
+			}
+		}
+	}
+	if op := operation(t, serve(router, http.MethodGet, "/v1beta1/"+name, "")); op.GetDone() {
+		t.Errorf("want the operation untouched by requests with verbs, got %+v", op)
+	}
+}
+
+func TestOperationsHandlers_cancel(t *testing.T) {
+	router, backend := newOperationsRouter()
+	name := startWait(t, backend, time.Hour)
+
+	if w := serve(router, http.MethodPost, "/v1beta1/"+name+":cancel", ""); w.Code != http.StatusOK {
+		t.Fatalf("cancel: got status %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	op := operation(t, serve(router, http.MethodGet, "/v1beta1/"+name, ""))
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Canceled {
+		t.Errorf("get after cancel: want a cancelled operation, got %+v", op)
+	}
+
+	if w := serve(router, http.MethodPost, "/v1beta1/operations/missing:cancel", "{}"); w.Code != http.StatusNotFound {
+		t.Errorf("cancel a missing operation: got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestOperationsHandlers_delete(t *testing.T) {
+	router, backend := newOperationsRouter()
+	name := startWait(t, backend, time.Hour)
+
+	if w := serve(router, http.MethodDelete, "/v1beta1/"+name, ""); w.Code != http.StatusOK {
+		t.Fatalf("delete: got status %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	if w := serve(router, http.MethodGet, "/v1beta1/"+name, ""); w.Code != http.StatusNotFound {
+		t.Errorf("get after delete: got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if w := serve(router, http.MethodDelete, "/v1beta1/"+name, ""); w.Code != http.StatusNotFound {
+		t.Errorf("second delete: got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestOperationsHandlers_wait(t *testing.T) {
+	router, backend := newOperationsRouter()
+	pending := startWait(t, backend, time.Hour)
+	soon := startWait(t, backend, 50*time.Millisecond)
+
+	op := operation(t, serve(router, http.MethodPost, "/v1beta1/"+pending+":wait", `{"timeout": "0.05s"}`))
+	if op.GetName() != pending || op.GetDone() {
+		t.Errorf("wait with a timeout: want the pending operation, got %+v", op)
+	}
+	op = operation(t, serve(router, http.MethodPost, "/v1beta1/"+soon+":wait", ""))
+	if op.GetName() != soon || !op.GetDone() {
+		t.Errorf("wait: want the finished operation, got %+v", op)
+	}
+
+	if w := serve(router, http.MethodPost, "/v1beta1/"+pending+":wait", `{"timeout": 5}`); w.Code != http.StatusBadRequest {
+		t.Errorf("wait with an invalid body: got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -77,10 +77,12 @@ func (backend *RESTBackend) HandleCreateSequence(w http.ResponseWriter, r *http.
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.CreateSequence(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.SequenceServiceServer, "/google.showcase.v1beta1.SequenceService/CreateSequence", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.SequenceServiceServer.CreateSequence(ctx, req.(*genprotopb.CreateSequenceRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -132,10 +134,12 @@ func (backend *RESTBackend) HandleGetSequenceReport(w http.ResponseWriter, r *ht
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.GetSequenceReport(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.SequenceServiceServer, "/google.showcase.v1beta1.SequenceService/GetSequenceReport", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.SequenceServiceServer.GetSequenceReport(ctx, req.(*genprotopb.GetSequenceReportRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -184,10 +188,12 @@ func (backend *RESTBackend) HandleAttemptSequence(w http.ResponseWriter, r *http
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.AttemptSequence(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.SequenceServiceServer, "/google.showcase.v1beta1.SequenceService/AttemptSequence", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.SequenceServiceServer.AttemptSequence(ctx, req.(*genprotopb.AttemptSequenceRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
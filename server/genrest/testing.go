@@ -77,10 +77,12 @@ func (backend *RESTBackend) HandleCreateSession(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.CreateSession(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/CreateSession", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.CreateSession(ctx, req.(*genprotopb.CreateSessionRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -132,10 +134,12 @@ func (backend *RESTBackend) HandleGetSession(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.GetSession(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/GetSession", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.GetSession(ctx, req.(*genprotopb.GetSessionRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -187,10 +191,12 @@ func (backend *RESTBackend) HandleListSessions(w http.ResponseWriter, r *http.Re
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.ListSessions(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/ListSessions", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.ListSessions(ctx, req.(*genprotopb.ListSessionsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -242,10 +248,12 @@ func (backend *RESTBackend) HandleDeleteSession(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.DeleteSession(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/DeleteSession", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.DeleteSession(ctx, req.(*genprotopb.DeleteSessionRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -297,10 +305,12 @@ func (backend *RESTBackend) HandleReportSession(w http.ResponseWriter, r *http.R
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.ReportSession(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/ReportSession", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.ReportSession(ctx, req.(*genprotopb.ReportSessionRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -352,10 +362,12 @@ func (backend *RESTBackend) HandleListTests(w http.ResponseWriter, r *http.Reque
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.ListTests(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/ListTests", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.ListTests(ctx, req.(*genprotopb.ListTestsRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -407,10 +419,12 @@ func (backend *RESTBackend) HandleDeleteTest(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.DeleteTest(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/DeleteTest", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.DeleteTest(ctx, req.(*genprotopb.DeleteTestRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -462,10 +476,12 @@ func (backend *RESTBackend) HandleVerifyTest(w http.ResponseWriter, r *http.Requ
 	requestJSON, _ := marshaler.MarshalToString(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.TestingServer.VerifyTest(context.Background(), request)
+	response, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.TestingServer, "/google.showcase.v1beta1.Testing/VerifyTest", request,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return backend.TestingServer.VerifyTest(ctx, req.(*genprotopb.VerifyTestRequest))
+		})
 	if err != nil {
-		// TODO: Properly handle error
-		w.Write([]byte(err.Error()))
+		resttools.ErrorResponse(w, err)
 		return
 	}
 
@@ -0,0 +1,72 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	calls := []string{}
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name+" before")
+			resp, err := handler(ctx, req)
+			calls = append(calls, name+" after")
+			return resp, err
+		}
+	}
+	handler := func(_ context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return req, nil
+	}
+
+	chain := ChainUnaryInterceptors(record("first"), nil, record("second"))
+	resp, err := chain(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	if resp != "req" || err != nil {
+		t.Errorf("ChainUnaryInterceptors() = (%v, %v), want (req, nil)", resp, err)
+	}
+	want := []string{"first before", "second before", "handler", "second after", "first after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("ChainUnaryInterceptors() invocation order = %q, want %q", calls, want)
+	}
+}
+
+func TestChainStreamInterceptors(t *testing.T) {
+	calls := []string{}
+	record := func(name string) grpc.StreamServerInterceptor {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			calls = append(calls, name)
+			return handler(srv, ss)
+		}
+	}
+	handler := func(interface{}, grpc.ServerStream) error {
+		calls = append(calls, "handler")
+		return nil
+	}
+
+	chain := ChainStreamInterceptors(record("first"), record("second"))
+	if err := chain(nil, nil, &grpc.StreamServerInfo{}, handler); err != nil {
+		t.Errorf("ChainStreamInterceptors() unexpected error: %v", err)
+	}
+	want := []string{"first", "second", "handler"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("ChainStreamInterceptors() invocation order = %q, want %q", calls, want)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the buckets of
+// the request duration histograms. They match the Prometheus defaults.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// MetricsObserver is an observer that aggregates metrics about every
+// call it observes and serves them over HTTP in the Prometheus text
+// exposition format. It exposes:
+//
+//	showcase_requests_total{transport,method,code}
+//	showcase_request_duration_seconds{transport,method}
+//	showcase_stream_messages_total{method,direction}
+//
+// where transport is "grpc", "grpc-web" or "rest", and direction is either
+// "received" or "sent".
+type MetricsObserver struct {
+	mu       sync.Mutex
+	requests map[requestKey]uint64
+	latency  map[methodKey]*histogram
+	messages map[messageKey]uint64
+}
+
+type methodKey struct {
+	transport, method string
+}
+
+type requestKey struct {
+	methodKey
+	code string
+}
+
+type messageKey struct {
+	method, direction string
+}
+
+type histogram struct {
+	counts []uint64 // counts[i] is the number of observations <= latencyBuckets[i].
+	count  uint64
+	sum    float64
+}
+
+// NewMetricsObserver returns a MetricsObserver with no recorded calls.
+func NewMetricsObserver() *MetricsObserver {
+	return &MetricsObserver{
+		requests: map[requestKey]uint64{},
+		latency:  map[methodKey]*histogram{},
+		messages: map[messageKey]uint64{},
+	}
+}
+
+// GetName returns the name under which the observer is registered.
+func (m *MetricsObserver) GetName() string { return "metricsObserver" }
+
+// ObserveUnary records the status code and the duration of a unary call.
+func (m *MetricsObserver) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	m.observeCall(ctx, info.FullMethod, err)
+}
+
+// ObserveStreamEnd records the status code and the duration of a
+// streaming call.
+func (m *MetricsObserver) ObserveStreamEnd(
+	ctx context.Context,
+	info *grpc.StreamServerInfo,
+	err error) {
+	m.observeCall(ctx, info.FullMethod, err)
+}
+
+// observeCall records the status code and the duration of a completed
+// call.
+func (m *MetricsObserver) observeCall(ctx context.Context, method string, err error) {
+	key := methodKey{transport(ctx), method}
+	code := status.Code(err).String()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[requestKey{key, code}]++
+	if call, ok := CallInfoFromContext(ctx); ok {
+		h, ok := m.latency[key]
+		if !ok {
+			h = &histogram{counts: make([]uint64, len(latencyBuckets))}
+			m.latency[key] = h
+		}
+		h.observe(time.Since(call.Start).Seconds())
+	}
+}
+
+// ObserveStreamRequest counts the messages received on a stream.
+func (m *MetricsObserver) ObserveStreamRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if err != nil {
+		// The stream ended or failed: no message was received.
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages[messageKey{info.FullMethod, "received"}]++
+}
+
+// ObserveStreamResponse counts the messages sent on a stream.
+func (m *MetricsObserver) ObserveStreamResponse(
+	ctx context.Context,
+	resp interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if err != nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages[messageKey{info.FullMethod, "sent"}]++
+}
+
+// ServeHTTP writes the metrics recorded so far.
+func (m *MetricsObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics recorded so far to w in the Prometheus
+// text exposition format. Series are sorted so that the output is
+// deterministic.
+func (m *MetricsObserver) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("# HELP showcase_requests_total Number of calls completed, by status code.\n")
+	b.WriteString("# TYPE showcase_requests_total counter\n")
+	requestKeys := make([]requestKey, 0, len(m.requests))
+	for key := range m.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		a, b := requestKeys[i], requestKeys[j]
+		if a.methodKey != b.methodKey {
+			return a.methodKey.less(b.methodKey)
+		}
+		return a.code < b.code
+	})
+	for _, key := range requestKeys {
+		fmt.Fprintf(&b, "showcase_requests_total{transport=%s,method=%s,code=%s} %d\n",
+			quote(key.transport), quote(key.method), quote(key.code), m.requests[key])
+	}
+
+	b.WriteString("# HELP showcase_request_duration_seconds Duration of calls.\n")
+	b.WriteString("# TYPE showcase_request_duration_seconds histogram\n")
+	latencyKeys := make([]methodKey, 0, len(m.latency))
+	for key := range m.latency {
+		latencyKeys = append(latencyKeys, key)
+	}
+	sort.Slice(latencyKeys, func(i, j int) bool { return latencyKeys[i].less(latencyKeys[j]) })
+	for _, key := range latencyKeys {
+		h := m.latency[key]
+		labels := fmt.Sprintf("transport=%s,method=%s", quote(key.transport), quote(key.method))
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(&b, "showcase_request_duration_seconds_bucket{%s,le=%s} %d\n",
+				labels, quote(strconv.FormatFloat(bound, 'g', -1, 64)), h.counts[i])
+		}
+		fmt.Fprintf(&b, "showcase_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(&b, "showcase_request_duration_seconds_sum{%s} %g\n", labels, h.sum)
+		fmt.Fprintf(&b, "showcase_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	b.WriteString("# HELP showcase_stream_messages_total Number of stream messages, by direction.\n")
+	b.WriteString("# TYPE showcase_stream_messages_total counter\n")
+	messageKeys := make([]messageKey, 0, len(m.messages))
+	for key := range m.messages {
+		messageKeys = append(messageKeys, key)
+	}
+	sort.Slice(messageKeys, func(i, j int) bool {
+		a, b := messageKeys[i], messageKeys[j]
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.direction < b.direction
+	})
+	for _, key := range messageKeys {
+		fmt.Fprintf(&b, "showcase_stream_messages_total{method=%s,direction=%s} %d\n",
+			quote(key.method), quote(key.direction), m.messages[key])
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func (h *histogram) observe(seconds float64) {
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+func (k methodKey) less(other methodKey) bool {
+	if k.method != other.method {
+		return k.method < other.method
+	}
+	return k.transport < other.transport
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote formats s as a Prometheus label value.
+func quote(s string) string {
+	return `"` + labelEscaper.Replace(s) + `"`
+}
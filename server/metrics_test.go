@@ -0,0 +1,93 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsObserver(t *testing.T) {
+	registry := ShowcaseObserverRegistry()
+	metrics := NewMetricsObserver()
+	registry.RegisterUnaryObserver(metrics)
+	registry.RegisterStreamRequestObserver(metrics)
+	registry.RegisterStreamResponseObserver(metrics)
+	registry.(StreamEndObserverRegistry).RegisterStreamEndObserver(metrics)
+
+	attempt := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.SequenceService/AttemptSequence"}
+	results := []error{status.Error(codes.Unavailable, "retry"), status.Error(codes.Unavailable, "retry"), nil}
+	for _, result := range results {
+		handler := func(context.Context, interface{}) (interface{}, error) { return nil, result }
+		registry.UnaryInterceptor(context.Background(), "req", attempt, handler)
+	}
+	stream := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Chat"}
+	metrics.ObserveStreamRequest(context.Background(), "req", stream, nil)
+	metrics.ObserveStreamRequest(context.Background(), nil, stream, io.EOF)
+	metrics.ObserveStreamResponse(context.Background(), "resp", stream, nil)
+	metrics.ObserveStreamResponse(context.Background(), "resp", stream, nil)
+	expand := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Expand", IsServerStream: true}
+	failed := func(interface{}, grpc.ServerStream) error { return status.Error(codes.Aborted, "failed") }
+	registry.StreamInterceptor(nil, &testServerStream{}, expand, failed)
+
+	var b strings.Builder
+	if _, err := metrics.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo() failed: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		`showcase_requests_total{transport="rest",method="/google.showcase.v1beta1.SequenceService/AttemptSequence",code="OK"} 1`,
+		`showcase_requests_total{transport="rest",method="/google.showcase.v1beta1.SequenceService/AttemptSequence",code="Unavailable"} 2`,
+		`showcase_request_duration_seconds_bucket{transport="rest",method="/google.showcase.v1beta1.SequenceService/AttemptSequence",le="+Inf"} 3`,
+		`showcase_request_duration_seconds_count{transport="rest",method="/google.showcase.v1beta1.SequenceService/AttemptSequence"} 3`,
+		`showcase_requests_total{transport="rest",method="/google.showcase.v1beta1.Echo/Expand",code="Aborted"} 1`,
+		`showcase_request_duration_seconds_count{transport="rest",method="/google.showcase.v1beta1.Echo/Expand"} 1`,
+		`showcase_stream_messages_total{method="/google.showcase.v1beta1.Echo/Chat",direction="received"} 1`,
+		`showcase_stream_messages_total{method="/google.showcase.v1beta1.Echo/Chat",direction="sent"} 2`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteTo() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestMetricsObserver_latencyBuckets(t *testing.T) {
+	metrics := NewMetricsObserver()
+	call := &CallInfo{Transport: TransportREST, Start: time.Now().Add(-200 * time.Millisecond)}
+	ctx := context.WithValue(context.Background(), callInfoKey{}, call)
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Block"}
+	metrics.ObserveUnary(ctx, "req", nil, info, errors.New("failed"))
+
+	var b strings.Builder
+	metrics.WriteTo(&b)
+	got := b.String()
+	for _, want := range []string{
+		`showcase_requests_total{transport="rest",method="/google.showcase.v1beta1.Echo/Block",code="Unknown"} 1`,
+		`showcase_request_duration_seconds_bucket{transport="rest",method="/google.showcase.v1beta1.Echo/Block",le="0.1"} 0`,
+		`showcase_request_duration_seconds_bucket{transport="rest",method="/google.showcase.v1beta1.Echo/Block",le="0.25"} 1`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteTo() missing %q in:\n%s", want, got)
+		}
+	}
+}
@@ -17,8 +17,10 @@ package server
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // UnaryObserver provides an interface for observing unary requests and responses.
@@ -52,6 +54,16 @@ type StreamResponseObserver interface {
 		err error)
 }
 
+// StreamEndObserver provides an interface for observing the end of streams, along
+// with the error returned by their handler.
+type StreamEndObserver interface {
+	GetName() string
+	ObserveStreamEnd(
+		ctx context.Context,
+		info *grpc.StreamServerInfo,
+		err error)
+}
+
 // GrpcObserverRegistry is a registry of observers. These observers are hooked into the
 // grpc interceptors that are provided by this interface.
 type GrpcObserverRegistry interface {
@@ -77,23 +89,78 @@ type GrpcObserverRegistry interface {
 	DeleteStreamResponseObserver(name string)
 }
 
+// StreamEndObserverRegistry is implemented by the registries that can also notify
+// observers of the end of streams. It is kept apart from GrpcObserverRegistry so that
+// existing implementations of that interface remain valid; callers check for it with a
+// type assertion.
+type StreamEndObserverRegistry interface {
+	RegisterStreamEndObserver(StreamEndObserver)
+	DeleteStreamEndObserver(name string)
+}
+
 // ShowcaseObserverRegistry returns the showcase specific observer registry.
 func ShowcaseObserverRegistry() GrpcObserverRegistry {
-	return &showcaseObserverRegistry{
-		uObservers:     map[string]UnaryObserver{},
-		sReqObservers:  map[string]StreamRequestObserver{},
-		sRespObservers: map[string]StreamResponseObserver{},
-	}
+	return &showcaseObserverRegistry{}
 }
 
 // showcaseObserverRegistry is an implementation of the ObserverRegistry. This registry
 // automatically handles DeleteTest requests and deletes the appropriate observers
 // for that request.
+//
+// Calls are never serialized by the registry: the registered observers are kept in an
+// immutable observerSet that is replaced, rather than modified, whenever an observer is
+// registered or deleted. Each call observes the snapshot that is current once it completes.
+// Observers must therefore be safe for concurrent use.
 type showcaseObserverRegistry struct {
-	mu             sync.Mutex
+	mu        sync.Mutex   // serializes updates to observers
+	observers atomic.Value // *observerSet
+}
+
+// observerSet is a snapshot of the observers in a registry. It must not be modified once
+// it is stored in the registry.
+type observerSet struct {
 	uObservers     map[string]UnaryObserver
 	sReqObservers  map[string]StreamRequestObserver
 	sRespObservers map[string]StreamResponseObserver
+	sEndObservers  map[string]StreamEndObserver
+}
+
+var emptyObserverSet = &observerSet{}
+
+// snapshot returns the observers currently registered.
+func (r *showcaseObserverRegistry) snapshot() *observerSet {
+	if set, ok := r.observers.Load().(*observerSet); ok {
+		return set
+	}
+	return emptyObserverSet
+}
+
+// update replaces the registered observers by a copy of them modified by f.
+func (r *showcaseObserverRegistry) update(f func(*observerSet)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.snapshot()
+	next := &observerSet{
+		uObservers:     make(map[string]UnaryObserver, len(current.uObservers)),
+		sReqObservers:  make(map[string]StreamRequestObserver, len(current.sReqObservers)),
+		sRespObservers: make(map[string]StreamResponseObserver, len(current.sRespObservers)),
+		sEndObservers:  make(map[string]StreamEndObserver, len(current.sEndObservers)),
+	}
+	for name, obs := range current.uObservers {
+		next.uObservers[name] = obs
+	}
+	for name, obs := range current.sReqObservers {
+		next.sReqObservers[name] = obs
+	}
+	for name, obs := range current.sRespObservers {
+		next.sRespObservers[name] = obs
+	}
+	for name, obs := range current.sEndObservers {
+		next.sEndObservers[name] = obs
+	}
+	f(next)
+	r.observers.Store(next)
 }
 
 func (r *showcaseObserverRegistry) UnaryInterceptor(
@@ -101,43 +168,88 @@ func (r *showcaseObserverRegistry) UnaryInterceptor(
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (interface{}, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
+	ctx = withCallInfo(ctx, info.FullMethod, newCallInfo(ctx))
 	resp, err := handler(ctx, req)
 
-	for _, obs := range r.uObservers {
+	for _, obs := range r.snapshot().uObservers {
 		obs.ObserveUnary(ctx, req, resp, info, err)
 	}
 
 	return resp, err
 }
 
+// showcaseStream notifies the observers of a registry of the messages sent and received
+// on a stream. Observers are notified of the messages of a given stream one at a time, in
+// the order in which the messages were sent or received, but the stream itself is never
+// locked while sending or receiving.
 type showcaseStream struct {
 	info     *grpc.StreamServerInfo
 	registry *showcaseObserverRegistry
+	mu       sync.Mutex // serializes the notifications for this stream
+	ctx      context.Context
+	call     *CallInfo
 
 	grpc.ServerStream
 }
 
-func (s *showcaseStream) SendMsg(m interface{}) error {
-	s.registry.mu.Lock()
-	defer s.registry.mu.Unlock()
+// newShowcaseStream wraps ss, attaching a new CallInfo to its context.
+func newShowcaseStream(r *showcaseObserverRegistry, ss grpc.ServerStream, info *grpc.StreamServerInfo) *showcaseStream {
+	call := newCallInfo(ss.Context())
+	return &showcaseStream{
+		info:         info,
+		registry:     r,
+		ctx:          context.WithValue(ss.Context(), callInfoKey{}, call),
+		call:         call,
+		ServerStream: ss,
+	}
+}
+
+func (s *showcaseStream) Context() context.Context {
+	if s.ctx == nil {
+		return s.ServerStream.Context()
+	}
+	return s.ctx
+}
 
+func (s *showcaseStream) SetHeader(md metadata.MD) error {
+	if s.call != nil {
+		s.call.addHeader(md)
+	}
+	return s.ServerStream.SetHeader(md)
+}
+
+func (s *showcaseStream) SendHeader(md metadata.MD) error {
+	if s.call != nil {
+		s.call.addHeader(md)
+	}
+	return s.ServerStream.SendHeader(md)
+}
+
+func (s *showcaseStream) SetTrailer(md metadata.MD) {
+	if s.call != nil {
+		s.call.addTrailer(md)
+	}
+	s.ServerStream.SetTrailer(md)
+}
+
+func (s *showcaseStream) SendMsg(m interface{}) error {
 	err := s.ServerStream.SendMsg(m)
-	for _, obs := range s.registry.sRespObservers {
-		obs.ObserveStreamResponse(s.ServerStream.Context(), m, s.info, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, obs := range s.registry.snapshot().sRespObservers {
+		obs.ObserveStreamResponse(s.Context(), m, s.info, err)
 	}
 	return err
 }
 
 func (s *showcaseStream) RecvMsg(m interface{}) error {
-	s.registry.mu.Lock()
-	defer s.registry.mu.Unlock()
-
 	err := s.ServerStream.RecvMsg(m)
-	for _, obs := range s.registry.sReqObservers {
-		obs.ObserveStreamRequest(s.ServerStream.Context(), m, s.info, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, obs := range s.registry.snapshot().sReqObservers {
+		obs.ObserveStreamRequest(s.Context(), m, s.info, err)
 	}
 	return err
 }
@@ -147,47 +259,53 @@ func (r *showcaseObserverRegistry) StreamInterceptor(
 	ss grpc.ServerStream,
 	info *grpc.StreamServerInfo,
 	handler grpc.StreamHandler) error {
-	return handler(srv, &showcaseStream{info, r, ss})
+	stream := newShowcaseStream(r, ss, info)
+	err := handler(srv, stream)
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	for _, obs := range r.snapshot().sEndObservers {
+		obs.ObserveStreamEnd(stream.Context(), info, err)
+	}
+	return err
 }
 
 // RegisterUnaryObserver registers a unary observer. If an observer of the same name
 // has already been registered, the new observer will override it.
 func (r *showcaseObserverRegistry) RegisterUnaryObserver(obs UnaryObserver) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.uObservers[obs.GetName()] = obs
+	r.update(func(set *observerSet) { set.uObservers[obs.GetName()] = obs })
 }
 
 func (r *showcaseObserverRegistry) DeleteUnaryObserver(name string) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	delete(r.uObservers, name)
+	r.update(func(set *observerSet) { delete(set.uObservers, name) })
 }
 
 // RegisterStreamRequestObserver registers a stream observer. If an observer of the same name
 // has already been registered, the new observer will override it.
 func (r *showcaseObserverRegistry) RegisterStreamRequestObserver(obs StreamRequestObserver) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.sReqObservers[obs.GetName()] = obs
+	r.update(func(set *observerSet) { set.sReqObservers[obs.GetName()] = obs })
 }
 
 func (r *showcaseObserverRegistry) DeleteStreamRequestObserver(name string) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	delete(r.sReqObservers, name)
+	r.update(func(set *observerSet) { delete(set.sReqObservers, name) })
 }
 
 // RegisterStreamResponseObserver registers a stream observer. If an observer of the same name
 // has already been registered, the new observer will override it.
 func (r *showcaseObserverRegistry) RegisterStreamResponseObserver(obs StreamResponseObserver) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.sRespObservers[obs.GetName()] = obs
+	r.update(func(set *observerSet) { set.sRespObservers[obs.GetName()] = obs })
 }
 
 func (r *showcaseObserverRegistry) DeleteStreamResponseObserver(name string) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	delete(r.sRespObservers, name)
+	r.update(func(set *observerSet) { delete(set.sRespObservers, name) })
+}
+
+// RegisterStreamEndObserver registers a stream observer. If an observer of the same name
+// has already been registered, the new observer will override it.
+func (r *showcaseObserverRegistry) RegisterStreamEndObserver(obs StreamEndObserver) {
+	r.update(func(set *observerSet) { set.sEndObservers[obs.GetName()] = obs })
+}
+
+func (r *showcaseObserverRegistry) DeleteStreamEndObserver(name string) {
+	r.update(func(set *observerSet) { delete(set.sEndObservers, name) })
 }
@@ -18,7 +18,10 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 )
@@ -75,9 +78,8 @@ func Test_showcaseObserverRegistry_UnaryInterceptor(t *testing.T) {
 	for _, tt := range tests {
 		obs := &testUnaryObserver{name: observerName}
 		t.Run(tt.name, func(t *testing.T) {
-			r := &showcaseObserverRegistry{
-				uObservers: map[string]UnaryObserver{obs.GetName(): obs},
-			}
+			r := &showcaseObserverRegistry{}
+			r.RegisterUnaryObserver(obs)
 			handler := func(_ context.Context, req interface{}) (interface{}, error) {
 				if req != tt.req {
 					t.Errorf("showcaseObserverRegistry.UnaryInterceptor() want to invoke handler with %v, got %v", tt.req, req)
@@ -92,7 +94,7 @@ func Test_showcaseObserverRegistry_UnaryInterceptor(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.resp) {
 				t.Errorf("showcaseObserverRegistry.UnaryInterceptor() = %v, want %v", got, tt.resp)
 			}
-			if tt.observerDeleted && r.uObservers[observerName] != nil {
+			if tt.observerDeleted && r.snapshot().uObservers[observerName] != nil {
 				t.Error("showcaseObserverRegistry.UnaryInterceptor() want delete observers but did not")
 			}
 			if !tt.observerDeleted && obs.req != tt.req {
@@ -168,11 +170,11 @@ func Test_showcaseStream_SendMsg(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			registry := &showcaseObserverRegistry{sRespObservers: map[string]StreamResponseObserver{}}
+			registry := &showcaseObserverRegistry{}
 			obs := &testStreamResponseObserver{name: "streamObserver"}
 			registry.RegisterStreamResponseObserver(obs)
 			ss := &testServerStream{err: tt.err}
-			s := &showcaseStream{tt.info, registry, ss}
+			s := &showcaseStream{info: tt.info, registry: registry, ServerStream: ss}
 			if err := s.SendMsg(tt.msg); err != tt.err {
 				t.Errorf("showcaseStream.SendMsg() error = %v, want %v", err, tt.err)
 			}
@@ -227,11 +229,11 @@ func Test_showcaseStream_RecvMsg(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			registry := &showcaseObserverRegistry{sReqObservers: map[string]StreamRequestObserver{}}
+			registry := &showcaseObserverRegistry{}
 			obs := &testStreamRequestObserver{name: "streamObserver"}
 			registry.RegisterStreamRequestObserver(obs)
 			ss := &testServerStream{err: tt.err}
-			s := &showcaseStream{tt.info, registry, ss}
+			s := &showcaseStream{info: tt.info, registry: registry, ServerStream: ss}
 			if err := s.RecvMsg(tt.msg); err != tt.err {
 				t.Errorf("showcaseStream.RecvMsg() error = %v, want %v", err, tt.err)
 			}
@@ -277,3 +279,93 @@ func Test_showcaseObserverRegistry_StreamInterceptor(t *testing.T) {
 		t.Errorf("showcaseObserverRegistry.StreamInterceptor() error = %v, wantErr %v", err, tErr)
 	}
 }
+
+type countingUnaryObserver struct {
+	name  string
+	count int32
+}
+
+func (o *countingUnaryObserver) GetName() string { return o.name }
+
+func (o *countingUnaryObserver) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	atomic.AddInt32(&o.count, 1)
+}
+
+func Test_showcaseObserverRegistry_UnaryInterceptor_concurrent(t *testing.T) {
+	r := ShowcaseObserverRegistry()
+	obs := &countingUnaryObserver{name: "observerName"}
+	r.RegisterUnaryObserver(obs)
+	info := &grpc.UnaryServerInfo{}
+
+	// The first call only completes once the second one has run, which
+	// requires the registry not to serialize calls.
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := r.UnaryInterceptor(context.Background(), "blocked", info, func(context.Context, interface{}) (interface{}, error) {
+			<-release
+			return "resp", nil
+		})
+		done <- err
+	}()
+	_, err := r.UnaryInterceptor(context.Background(), "req", info, func(context.Context, interface{}) (interface{}, error) {
+		// Handlers may update the registry, as CreateSession does.
+		r.RegisterUnaryObserver(&countingUnaryObserver{name: "registeredByHandler"})
+		close(release)
+		return "resp", nil
+	})
+	if err != nil {
+		t.Errorf("showcaseObserverRegistry.UnaryInterceptor() error = %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("showcaseObserverRegistry.UnaryInterceptor() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("showcaseObserverRegistry.UnaryInterceptor() serialized concurrent calls")
+	}
+	if got := atomic.LoadInt32(&obs.count); got != 2 {
+		t.Errorf("showcaseObserverRegistry.UnaryInterceptor() notified observer %d times, want 2", got)
+	}
+}
+
+type orderedStreamObserver struct {
+	name     string
+	mu       sync.Mutex
+	observed []interface{}
+}
+
+func (o *orderedStreamObserver) GetName() string { return o.name }
+
+func (o *orderedStreamObserver) ObserveStreamResponse(
+	ctx context.Context,
+	resp interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.observed = append(o.observed, resp)
+}
+
+func Test_showcaseStream_SendMsg_ordered(t *testing.T) {
+	registry := &showcaseObserverRegistry{}
+	obs := &orderedStreamObserver{name: "streamObserver"}
+	registry.RegisterStreamResponseObserver(obs)
+	s := &showcaseStream{info: &grpc.StreamServerInfo{}, registry: registry, ServerStream: &testServerStream{}}
+
+	want := []interface{}{}
+	for i := 0; i < 100; i++ {
+		want = append(want, i)
+		s.SendMsg(i)
+	}
+	if !reflect.DeepEqual(obs.observed, want) {
+		t.Errorf("showcaseStream.SendMsg() observed %v, want %v", obs.observed, want)
+	}
+}
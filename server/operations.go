@@ -0,0 +1,385 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// operationPollInterval is how often OperationStore.Wait refreshes the
+// operation it waits on.
+const operationPollInterval = 10 * time.Millisecond
+
+// maxFinishedOperations is how many operations that are done a store
+// keeps. Beyond that, the oldest ones are removed as new operations are
+// registered.
+const maxFinishedOperations = 1000
+
+var operationStoreSingleton = NewOperationStore()
+
+// GetOperationStore returns the operation store singleton, in which the
+// Showcase services that serve a single tenant register the operations
+// they start.
+func GetOperationStore() *OperationStore {
+	return operationStoreSingleton
+}
+
+// OperationPoller advances the work of an operation that is not done. It
+// is called with the context of each call reading the operation, until it
+// returns an operation that is done. polled is true when a client polls
+// the operation with Get, and false when it is only refreshed, as when it
+// is listed or waited on. The name of the operation it returns is ignored.
+type OperationPoller func(ctx context.Context, polled bool) *lropb.Operation
+
+// OperationStore holds the long-running operations started by the
+// Showcase services, so that they can be read, listed, cancelled, deleted
+// and waited on through the Operations service. The requests that started
+// them are kept by their pollers, so operations are lost when the server
+// restarts. Only the latest maxFinishedOperations operations that are
+// done are kept.
+type OperationStore struct {
+	mu     sync.Mutex
+	ops    map[string]*operationEntry
+	names  []string // in order of registration
+	prefix string   // random, so that names are never reused
+	lastID int
+	token  TokenGenerator
+}
+
+type operationEntry struct {
+	op   *lropb.Operation
+	poll OperationPoller // nil once the operation is done
+}
+
+// NewOperationStore returns an empty OperationStore.
+func NewOperationStore() *OperationStore {
+	prefix := make([]byte, 4)
+	rand.Read(prefix)
+	return &OperationStore{
+		ops:    map[string]*operationEntry{},
+		prefix: hex.EncodeToString(prefix),
+		token:  NewTokenGenerator(),
+	}
+}
+
+// NewName returns a new operation name in the given collection, such as
+// "operations/echo/wait/5f2c9a1e-1" for "echo/wait". Names are short and
+// opaque, so that they can be passed in gRPC metadata and REST paths as
+// they are. They start with a prefix chosen at random for each store, so
+// that the names handed out before the server restarted, or before a
+// tenant was deleted, never refer to a new operation.
+func (s *OperationStore) NewName(collection string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	return fmt.Sprintf("operations/%s/%s-%d", collection, s.prefix, s.lastID)
+}
+
+// Register adds op to the store, replacing any operation with the same
+// name. Unless op is done, poll is called to advance it each time it is
+// read.
+func (s *OperationStore) Register(op *lropb.Operation, poll OperationPoller) {
+	entry := &operationEntry{op: proto.Clone(op).(*lropb.Operation)}
+	if !op.GetDone() {
+		entry.poll = poll
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.ops[op.GetName()]; !ok {
+		s.names = append(s.names, op.GetName())
+	}
+	s.ops[op.GetName()] = entry
+	s.evictFinished()
+}
+
+// evictFinished removes the oldest operations that are done beyond
+// maxFinishedOperations. The caller must hold s.mu.
+func (s *OperationStore) evictFinished() {
+	finished := 0
+	for _, name := range s.names {
+		if s.ops[name].poll == nil {
+			finished++
+		}
+	}
+	if finished <= maxFinishedOperations {
+		return
+	}
+	names := s.names[:0]
+	for _, name := range s.names {
+		if finished > maxFinishedOperations && s.ops[name].poll == nil {
+			delete(s.ops, name)
+			finished--
+			continue
+		}
+		names = append(names, name)
+	}
+	s.names = names
+}
+
+// Reset removes all the operations from the store, stopping their work.
+// The names of the operations started afterwards do not start over, so
+// that they never refer to a removed operation.
+func (s *OperationStore) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entry := range s.ops {
+		entry.poll = nil
+	}
+	s.ops = map[string]*operationEntry{}
+	s.names = nil
+}
+
+// Dump returns the operations of the store in order of registration, in
+// their current state. Unlike Get and List, it does not advance them.
+func (s *OperationStore) Dump() []*lropb.Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ops := make([]*lropb.Operation, 0, len(s.names))
+	for _, name := range s.names {
+		ops = append(ops, proto.Clone(s.ops[name].op).(*lropb.Operation))
+	}
+	return ops
+}
+
+// Len returns the number of operations in the store.
+func (s *OperationStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.names)
+}
+
+// Get polls the named operation, unless it is done, and returns its
+// current state.
+func (s *OperationStore) Get(ctx context.Context, name string) (*lropb.Operation, error) {
+	return s.read(ctx, name, true)
+}
+
+// read returns the current state of the named operation, advancing it if
+// it is not done.
+func (s *OperationStore) read(ctx context.Context, name string, polled bool) (*lropb.Operation, error) {
+	s.mu.Lock()
+	entry, ok := s.ops[name]
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", name)
+	}
+	return s.advance(ctx, name, entry, polled), nil
+}
+
+// advance calls the poller of entry, unless it is done, and returns a
+// copy of the resulting operation. The store is not locked while polling,
+// so that pollers may take their time and call other services.
+func (s *OperationStore) advance(ctx context.Context, name string, entry *operationEntry, polled bool) *lropb.Operation {
+	s.mu.Lock()
+	poll := entry.poll
+	s.mu.Unlock()
+	if poll == nil {
+		return s.snapshot(entry)
+	}
+
+	op := poll(ctx, polled)
+	op.Name = name
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The operation may have been cancelled while it was polled, in
+	// which case the result of its work is dropped.
+	if entry.poll != nil {
+		entry.op = op
+		if op.GetDone() {
+			entry.poll = nil
+		}
+	}
+	return proto.Clone(entry.op).(*lropb.Operation)
+}
+
+func (s *OperationStore) snapshot(entry *operationEntry) *lropb.Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return proto.Clone(entry.op).(*lropb.Operation)
+}
+
+// List returns a page of the operations whose names start with
+// parent + "/" and that match filter, refreshing those that are not done
+// without polling them, along with the token of the next page if any. Filters are documented
+// in parseOperationFilter.
+func (s *OperationStore) List(ctx context.Context, parent, filter string, pageSize int32, pageToken string) ([]*lropb.Operation, string, error) {
+	match, err := parseOperationFilter(filter)
+	if err != nil {
+		return nil, "", err
+	}
+	start, err := s.token.GetIndex(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	names := []string{}
+	entries := []*operationEntry{}
+	for _, name := range s.names {
+		if strings.HasPrefix(name, parent+"/") {
+			names = append(names, name)
+			entries = append(entries, s.ops[name])
+		}
+	}
+	s.mu.Unlock()
+
+	ops := []*lropb.Operation{}
+	for i, entry := range entries {
+		if op := s.advance(ctx, names[i], entry, false); match(op) {
+			ops = append(ops, op)
+		}
+	}
+	if start > len(ops) {
+		return nil, "", InvalidTokenErr
+	}
+	end := len(ops)
+	if pageSize > 0 && start+int(pageSize) < end {
+		end = start + int(pageSize)
+	}
+	nextToken := ""
+	if end < len(ops) {
+		nextToken = s.token.ForIndex(end)
+	}
+	return ops[start:end], nextToken, nil
+}
+
+// Cancel stops the work of the named operation, which is then done with
+// a CANCELLED error. Operations that are already done are left as they
+// are.
+func (s *OperationStore) Cancel(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.ops[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "Operation %q not found.", name)
+	}
+	if entry.op.GetDone() {
+		return nil
+	}
+	entry.poll = nil
+	entry.op.Done = true
+	entry.op.Result = &lropb.Operation_Error{
+		Error: &statuspb.Status{
+			Code:    int32(codes.Canceled),
+			Message: "The operation was cancelled.",
+		},
+	}
+	return nil
+}
+
+// Delete removes the named operation from the store, stopping its work.
+func (s *OperationStore) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.ops[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "Operation %q not found.", name)
+	}
+	entry.poll = nil
+	delete(s.ops, name)
+	for i, n := range s.names {
+		if n == name {
+			s.names = append(s.names[:i], s.names[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// Wait refreshes the named operation, without polling it, until it is
+// done, timeout has elapsed or ctx is done, and returns its latest state.
+// A timeout of 0 waits for as long as ctx allows.
+func (s *OperationStore) Wait(ctx context.Context, name string, timeout time.Duration) (*lropb.Operation, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		op, err := s.read(ctx, name, false)
+		if err != nil || op.GetDone() {
+			return op, err
+		}
+		d := operationPollInterval
+		if timeout > 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return op, nil
+			}
+			if remaining < d {
+				d = remaining
+			}
+		}
+		if err := SleepRealTime(ctx, d); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseOperationFilter returns the predicate matching the operations
+// selected by filter, a conjunction of terms joined by "AND". Terms are
+// either `done = true`, `done = false`, `name = "operations/..."` for an
+// exact name or `name : "..."` for names containing a string. An empty
+// filter matches every operation.
+func parseOperationFilter(filter string) (func(*lropb.Operation) bool, error) {
+	preds := []func(*lropb.Operation) bool{}
+	for _, term := range strings.Split(filter, " AND ") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		i := strings.IndexAny(term, "=:")
+		if i < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid filter term %q: expected `field = value` or `field : value`.", term)
+		}
+		field, op, value := strings.TrimSpace(term[:i]), term[i], strings.TrimSpace(term[i+1:])
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		switch {
+		case field == "done" && op == '=':
+			done, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid filter term %q: done must be true or false.", term)
+			}
+			preds = append(preds, func(o *lropb.Operation) bool { return o.GetDone() == done })
+		case field == "name" && op == '=':
+			preds = append(preds, func(o *lropb.Operation) bool { return o.GetName() == value })
+		case field == "name" && op == ':':
+			preds = append(preds, func(o *lropb.Operation) bool { return strings.Contains(o.GetName(), value) })
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid filter term %q: only `done =`, `name =` and `name :` are supported.", term)
+		}
+	}
+	return func(o *lropb.Operation) bool {
+		for _, pred := range preds {
+			if !pred(o) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
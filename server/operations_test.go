@@ -0,0 +1,176 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOperationStore_newName(t *testing.T) {
+	s := NewOperationStore()
+	want := []string{"operations/echo/wait/%s-1", "operations/messaging/search-blurbs/%s-2", "operations/echo/wait/%s-3"}
+	got := []string{s.NewName("echo/wait"), s.NewName("messaging/search-blurbs"), s.NewName("echo/wait")}
+	if !regexp.MustCompile(`^[0-9a-f]{8}$`).MatchString(s.prefix) {
+		t.Fatalf("NewOperationStore: want a random prefix of 8 hex digits, got %q", s.prefix)
+	}
+	for i := range want {
+		if w := fmt.Sprintf(want[i], s.prefix); got[i] != w {
+			t.Errorf("NewName: want %q, got %q", w, got[i])
+		}
+	}
+
+	// Another store, as after a restart, never hands out the same names.
+	if other := NewOperationStore(); other.NewName("echo/wait") == got[0] {
+		t.Errorf("NewName: want names unique across stores, got %q twice", got[0])
+	}
+}
+
+func TestOperationStore_cancelWhilePolling(t *testing.T) {
+	s := NewOperationStore()
+	polling, cancelled := make(chan struct{}), make(chan struct{})
+	s.Register(&lropb.Operation{Name: "operations/test"}, func(context.Context, bool) *lropb.Operation {
+		close(polling)
+		<-cancelled
+		return &lropb.Operation{Done: true}
+	})
+
+	result := make(chan *lropb.Operation)
+	go func() {
+		op, _ := s.Get(context.Background(), "operations/test")
+		result <- op
+	}()
+	<-polling
+	if err := s.Cancel("operations/test"); err != nil {
+		t.Fatalf("Cancel: unexpected err %+v", err)
+	}
+	close(cancelled)
+
+	if op := <-result; codes.Code(op.GetError().GetCode()) != codes.Canceled {
+		t.Errorf("Get: want the result of the cancelled work to be dropped, got %+v", op)
+	}
+}
+
+func TestOperationStore_evictFinished(t *testing.T) {
+	s := NewOperationStore()
+	pending := func(context.Context, bool) *lropb.Operation { return &lropb.Operation{} }
+	s.Register(&lropb.Operation{Name: "operations/pending"}, pending)
+	for i := 0; i < maxFinishedOperations+5; i++ {
+		s.Register(&lropb.Operation{Name: s.NewName("test"), Done: true}, nil)
+	}
+
+	if got, want := s.Len(), maxFinishedOperations+1; got != want {
+		t.Fatalf("Len: want %d operations, got %d", want, got)
+	}
+	if _, err := s.Get(context.Background(), "operations/pending"); err != nil {
+		t.Errorf("Get: want the pending operation to be kept, got %v", err)
+	}
+	for i := 1; i <= 5; i++ {
+		name := fmt.Sprintf("operations/test/%s-%d", s.prefix, i)
+		if _, err := s.Get(context.Background(), name); status.Code(err) != codes.NotFound {
+			t.Errorf("Get(%q): want the oldest finished operations removed, got %v", name, err)
+		}
+	}
+	if _, err := s.Get(context.Background(), fmt.Sprintf("operations/test/%s-6", s.prefix)); err != nil {
+		t.Errorf("Get: want the newer finished operations to be kept, got %v", err)
+	}
+}
+
+func TestOperationStore_resetAndDump(t *testing.T) {
+	s := NewOperationStore()
+	polls := 0
+	s.Register(&lropb.Operation{Name: s.NewName("test")}, func(context.Context, bool) *lropb.Operation {
+		polls++
+		return &lropb.Operation{}
+	})
+	s.Register(&lropb.Operation{Name: s.NewName("test"), Done: true}, nil)
+
+	first, second := "operations/test/"+s.prefix+"-1", "operations/test/"+s.prefix+"-2"
+	ops := s.Dump()
+	if len(ops) != 2 || ops[0].GetName() != first || ops[1].GetName() != second {
+		t.Errorf("Dump: want both operations in order, got %v", ops)
+	}
+	if polls != 0 {
+		t.Errorf("Dump: want no polls, got %d", polls)
+	}
+
+	s.Reset()
+	if s.Len() != 0 || len(s.Dump()) != 0 {
+		t.Errorf("Reset: want an empty store, got %v", s.Dump())
+	}
+	if _, err := s.Get(context.Background(), first); status.Code(err) != codes.NotFound {
+		t.Errorf("Get: want NotFound after Reset, got %v", err)
+	}
+	if name := s.NewName("test"); name != "operations/test/"+s.prefix+"-3" {
+		t.Errorf("NewName: want names not to start over after Reset, got %q", name)
+	}
+}
+
+func TestOperationStore_wait(t *testing.T) {
+	clock := NewClock()
+	clock.Freeze()
+	s := NewOperationStore()
+	w := &waiterImpl{nowF: clock.Now, operations: s}
+	op := w.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Hour)}})
+
+	got, err := s.Wait(context.Background(), op.GetName(), 20*time.Millisecond)
+	if err != nil || got.GetDone() {
+		t.Errorf("Wait: want a pending operation while the clock is frozen, got %+v, %v", got, err)
+	}
+	clock.Advance(2 * time.Hour)
+	got, err = s.Wait(context.Background(), op.GetName(), time.Second)
+	if err != nil || !got.GetDone() {
+		t.Errorf("Wait: want a finished operation once the clock is advanced, got %+v, %v", got, err)
+	}
+}
+
+func TestParseOperationFilter(t *testing.T) {
+	op := &lropb.Operation{Name: "operations/echo/1", Done: true}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"", true},
+		{"done = true", true},
+		{"done = false", false},
+		{`name = "operations/echo/1"`, true},
+		{`name : "echo" AND done = true`, true},
+		{`name : "blurbs"`, false},
+	}
+	for _, test := range tests {
+		match, err := parseOperationFilter(test.filter)
+		if err != nil {
+			t.Errorf("parseOperationFilter(%q): unexpected err %+v", test.filter, err)
+			continue
+		}
+		if got := match(op); got != test.want {
+			t.Errorf("parseOperationFilter(%q): want %v, got %v", test.filter, test.want, got)
+		}
+	}
+	for _, filter := range []string{"done", "done = maybe", "metadata = 1", "done : true"} {
+		if _, err := parseOperationFilter(filter); err == nil {
+			t.Errorf("parseOperationFilter(%q): want error", filter)
+		}
+	}
+}
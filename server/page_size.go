@@ -0,0 +1,45 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// PageSizeLimiter returns an interceptor that coerces the `page_size`
+// field of paginated requests to be at most max. Requests that leave
+// the page size unset are given max as well, as a server-chosen
+// default.
+func PageSizeLimiter(max int32) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		r := msg.ProtoReflect()
+		fd := r.Descriptor().Fields().ByName("page_size")
+		if fd != nil && fd.Kind() == protoreflect.Int32Kind && fd.Cardinality() != protoreflect.Repeated {
+			if size := int32(r.Get(fd).Int()); size <= 0 || size > max {
+				r.Set(fd, protoreflect.ValueOfInt32(max))
+			}
+		}
+		return handler(ctx, req)
+	}
+}
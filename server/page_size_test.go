@@ -0,0 +1,54 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+)
+
+func TestPageSizeLimiter(t *testing.T) {
+	tests := []struct {
+		pageSize int32
+		want     int32
+	}{
+		{0, 10},
+		{-1, 10},
+		{5, 5},
+		{10, 10},
+		{50, 10},
+	}
+	limiter := PageSizeLimiter(10)
+	for _, tt := range tests {
+		req := &pb.ListUsersRequest{PageSize: tt.pageSize}
+		handler := func(_ context.Context, req interface{}) (interface{}, error) {
+			return req.(*pb.ListUsersRequest).GetPageSize(), nil
+		}
+		got, _ := limiter(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+		if got != tt.want {
+			t.Errorf("PageSizeLimiter(10) with page_size %d: got %d, want %d", tt.pageSize, got, tt.want)
+		}
+	}
+
+	// Requests without a page size are left untouched.
+	req := &pb.GetUserRequest{Name: "users/0"}
+	handler := func(_ context.Context, req interface{}) (interface{}, error) { return req, nil }
+	if got, _ := limiter(context.Background(), req, &grpc.UnaryServerInfo{}, handler); got != req {
+		t.Errorf("PageSizeLimiter(10) modified a request without a page size: %v", got)
+	}
+}
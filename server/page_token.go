@@ -40,6 +40,11 @@ func TokenGeneratorWithSalt(salt string) TokenGenerator {
 type TokenGenerator interface {
 	ForIndex(int) string
 	GetIndex(string) (int, error)
+
+	// Salt returns the salt of the generated tokens, so that a
+	// generator accepting the same tokens can be created with
+	// TokenGeneratorWithSalt.
+	Salt() string
 }
 
 // InvalidTokenErr is the error returned if the token provided is not
@@ -52,6 +57,10 @@ type tokenGenerator struct {
 	salt string
 }
 
+func (t *tokenGenerator) Salt() string {
+	return t.salt
+}
+
 func (t *tokenGenerator) ForIndex(i int) string {
 	return base64.StdEncoding.EncodeToString(
 		[]byte(fmt.Sprintf("%s%d", t.salt, i)))
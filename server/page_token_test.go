@@ -63,3 +63,12 @@ func Test_tokenGenerator_GetIndex(t *testing.T) {
 		t.Errorf("GetIndex: want 1, got %d", i)
 	}
 }
+
+func Test_tokenGenerator_Salt(t *testing.T) {
+	tok := NewTokenGenerator()
+	restored := TokenGeneratorWithSalt(tok.Salt())
+	i, err := restored.GetIndex(tok.ForIndex(3))
+	if err != nil || i != 3 {
+		t.Errorf("GetIndex: want 3, got %d, %v", i, err)
+	}
+}
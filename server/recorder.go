@@ -0,0 +1,260 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Formats of the capture files written by a Recorder.
+const (
+	// CaptureFormatJSONL writes one CapturedEvent per line, in the proto3
+	// JSON format.
+	CaptureFormatJSONL = "jsonl"
+
+	// CaptureFormatProto writes every CapturedEvent in the proto binary
+	// format, prefixed by its size as a varint. This is the format read by
+	// parseDelimitedFrom and written by writeDelimitedTo in Java.
+	CaptureFormatProto = "proto"
+)
+
+// Recorder is an observer that writes every call it observes to a capture
+// file, as a sequence of pb.CapturedEvent messages.
+type Recorder struct {
+	mu      sync.Mutex // serializes writes to w
+	w       io.Writer
+	format  string
+	stopped bool
+	err     error // the first write error, after which nothing is written
+}
+
+// NewRecorder returns a Recorder writing to w in the given format, which is
+// either CaptureFormatJSONL or CaptureFormatProto.
+func NewRecorder(w io.Writer, format string) (*Recorder, error) {
+	if format != CaptureFormatJSONL && format != CaptureFormatProto {
+		return nil, fmt.Errorf("unknown capture format %q: expected %q or %q", format, CaptureFormatJSONL, CaptureFormatProto)
+	}
+	return &Recorder{w: w, format: format}, nil
+}
+
+// GetName returns the name under which the observer is registered.
+func (r *Recorder) GetName() string { return "recorder" }
+
+// ObserveUnary records a unary call, along with its response or error.
+func (r *Recorder) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	event := newCapturedEvent(ctx, info.FullMethod, pb.CapturedEvent_UNARY)
+	event.Request = toAny(req)
+	if err == nil {
+		event.Response = toAny(resp)
+	}
+	event.Status = status.Convert(err).Proto()
+	r.write(event)
+}
+
+// ObserveStreamRequest records a message received on a stream.
+func (r *Recorder) ObserveStreamRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if err == io.EOF {
+		// The client closed its side of the stream.
+		return
+	}
+	event := newCapturedEvent(ctx, info.FullMethod, pb.CapturedEvent_STREAM_REQUEST)
+	if err == nil {
+		event.Request = toAny(req)
+	} else {
+		event.Status = status.Convert(err).Proto()
+	}
+	r.write(event)
+}
+
+// ObserveStreamResponse records a message sent on a stream.
+func (r *Recorder) ObserveStreamResponse(
+	ctx context.Context,
+	resp interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	event := newCapturedEvent(ctx, info.FullMethod, pb.CapturedEvent_STREAM_RESPONSE)
+	event.Response = toAny(resp)
+	if err != nil {
+		event.Status = status.Convert(err).Proto()
+	}
+	r.write(event)
+}
+
+// ObserveStreamEnd records the end of a stream, along with its final
+// status and the trailers set by its handler.
+func (r *Recorder) ObserveStreamEnd(
+	ctx context.Context,
+	info *grpc.StreamServerInfo,
+	err error) {
+	event := newCapturedEvent(ctx, info.FullMethod, pb.CapturedEvent_STREAM_END)
+	event.Status = status.Convert(err).Proto()
+	r.write(event)
+}
+
+// Stop stops writing calls to the capture file, so that it can be closed
+// even if some calls are still in flight.
+func (r *Recorder) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = true
+}
+
+// Err returns the error that stopped the recording, if any.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) write(event *pb.CapturedEvent) {
+	var record []byte
+	switch r.format {
+	case CaptureFormatJSONL:
+		json, err := (&jsonpb.Marshaler{}).MarshalToString(event)
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		record = append([]byte(json), '\n')
+	case CaptureFormatProto:
+		b, err := proto.Marshal(event)
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		size := make([]byte, binary.MaxVarintLen64)
+		record = append(size[:binary.PutUvarint(size, uint64(len(b)))], b...)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped || r.err != nil {
+		return
+	}
+	// Every event is written at once, so that the capture of a run
+	// that is interrupted is still readable.
+	_, r.err = r.w.Write(record)
+}
+
+func (r *Recorder) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// newCapturedEvent returns the event of the given kind for the call with
+// the given context.
+func newCapturedEvent(ctx context.Context, method string, kind pb.CapturedEvent_Kind) *pb.CapturedEvent {
+	event := &pb.CapturedEvent{
+		Method:    method,
+		Transport: transport(ctx),
+		Kind:      kind,
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		event.Metadata = redactCredentials(toHeaders(md))
+	}
+	if call, ok := CallInfoFromContext(ctx); ok {
+		event.CallId = call.ID
+		event.StartTime, _ = ptypes.TimestampProto(call.Start)
+		event.Elapsed = ptypes.DurationProto(time.Since(call.Start))
+		event.ResponseHeaders = toHeaders(call.Header())
+		event.ResponseTrailers = toHeaders(call.Trailer())
+		event.RequestCompression = call.RequestCompression
+		event.ResponseCompression = call.ResponseCompression
+	}
+	return event
+}
+
+// toHeaders returns the entries of md sorted by key.
+func toHeaders(md metadata.MD) []*pb.CapturedEvent_Header {
+	keys := make([]string, 0, len(md))
+	for key := range md {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	headers := make([]*pb.CapturedEvent_Header, 0, len(keys))
+	for _, key := range keys {
+		headers = append(headers, &pb.CapturedEvent_Header{Key: key, Values: md[key]})
+	}
+	return headers
+}
+
+// redactedValue replaces the values of the metadata entries carrying
+// credentials, so that capture files can be shared.
+const redactedValue = "REDACTED"
+
+// credentialHeaders are the keys of the metadata entries carrying
+// credentials: bearer tokens and JWTs, API keys and cookies.
+var credentialHeaders = map[string]bool{
+	AuthorizationHeader:   true,
+	"proxy-authorization": true,
+	APIKeyHeader:          true,
+	"cookie":              true,
+}
+
+// redactCredentials replaces the values of the entries of headers carrying
+// credentials by redactedValue.
+func redactCredentials(headers []*pb.CapturedEvent_Header) []*pb.CapturedEvent_Header {
+	for _, header := range headers {
+		if credentialHeaders[header.GetKey()] {
+			values := make([]string, len(header.Values))
+			for i := range values {
+				values[i] = redactedValue
+			}
+			header.Values = values
+		}
+	}
+	return headers
+}
+
+// toAny returns m packed in an Any, or nil if m is not a proto message.
+func toAny(m interface{}) *anypb.Any {
+	message, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	any, err := ptypes.MarshalAny(message)
+	if err != nil {
+		return nil
+	}
+	return any
+}
@@ -0,0 +1,241 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// recordCalls records a successful and a failed Echo call, as well as a
+// message received on the Chat stream.
+func recordCalls(t *testing.T, format string) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	recorder, err := NewRecorder(b, format)
+	if err != nil {
+		t.Fatalf("NewRecorder() failed: %v", err)
+	}
+	registry := ShowcaseObserverRegistry()
+	registry.RegisterUnaryObserver(recorder)
+	registry.RegisterStreamRequestObserver(recorder)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-goog-api-client", "gl-go/1.15"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	echo := func(ctx context.Context, req interface{}) (interface{}, error) {
+		grpc.SetHeader(ctx, metadata.Pairs("x-showcase", "header"))
+		grpc.SetTrailer(ctx, metadata.Pairs("x-showcase-trailer", "trailer"))
+		return &pb.EchoResponse{Content: req.(*pb.EchoRequest).GetContent()}, nil
+	}
+	registry.UnaryInterceptor(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}, info, echo)
+	fail := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Aborted, "failed")
+	}
+	registry.UnaryInterceptor(ctx, &pb.EchoRequest{}, info, fail)
+
+	stream := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Chat"}
+	recorder.ObserveStreamRequest(ctx, &pb.EchoRequest{}, stream, nil)
+	recorder.ObserveStreamRequest(ctx, nil, stream, io.EOF)
+
+	if err := recorder.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	return b
+}
+
+func checkCapturedEvents(t *testing.T, events []*pb.CapturedEvent) {
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3: %v", len(events), events)
+	}
+
+	echo := events[0]
+	if echo.GetMethod() != "/google.showcase.v1beta1.Echo/Echo" || echo.GetKind() != pb.CapturedEvent_UNARY {
+		t.Errorf("event = %v, want a unary Echo call", echo)
+	}
+	if echo.GetTransport() != TransportREST {
+		t.Errorf("event transport = %q, want %q", echo.GetTransport(), TransportREST)
+	}
+	if echo.GetCallId() == 0 || echo.GetCallId() == events[1].GetCallId() {
+		t.Errorf("call IDs = %d and %d, want distinct non-zero IDs", echo.GetCallId(), events[1].GetCallId())
+	}
+	if len(echo.GetMetadata()) != 1 || echo.GetMetadata()[0].GetKey() != "x-goog-api-client" {
+		t.Errorf("event metadata = %v, want the x-goog-api-client header", echo.GetMetadata())
+	}
+	if len(echo.GetResponseHeaders()) != 1 || echo.GetResponseHeaders()[0].GetKey() != "x-showcase" {
+		t.Errorf("event response headers = %v, want the x-showcase header", echo.GetResponseHeaders())
+	}
+	if len(echo.GetResponseTrailers()) != 1 || echo.GetResponseTrailers()[0].GetKey() != "x-showcase-trailer" {
+		t.Errorf("event response trailers = %v, want the x-showcase-trailer trailer", echo.GetResponseTrailers())
+	}
+	response := &pb.EchoResponse{}
+	if err := ptypes.UnmarshalAny(echo.GetResponse(), response); err != nil || response.GetContent() != "hello" {
+		t.Errorf("event response = %v (%v), want content %q", response, err, "hello")
+	}
+
+	failed := events[1]
+	if failed.GetStatus().GetCode() != int32(codes.Aborted) || failed.GetResponse() != nil {
+		t.Errorf("event = %v, want an Aborted status and no response", failed)
+	}
+
+	chat := events[2]
+	if chat.GetKind() != pb.CapturedEvent_STREAM_REQUEST || chat.GetRequest() == nil {
+		t.Errorf("event = %v, want a received Chat message", chat)
+	}
+}
+
+func TestRecorder_jsonl(t *testing.T) {
+	b := recordCalls(t, CaptureFormatJSONL)
+
+	var events []*pb.CapturedEvent
+	scanner := bufio.NewScanner(b)
+	for scanner.Scan() {
+		event := &pb.CapturedEvent{}
+		if err := jsonpb.Unmarshal(strings.NewReader(scanner.Text()), event); err != nil {
+			t.Fatalf("line %q is not a CapturedEvent: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+	checkCapturedEvents(t, events)
+}
+
+func TestRecorder_proto(t *testing.T) {
+	b := recordCalls(t, CaptureFormatProto)
+
+	var events []*pb.CapturedEvent
+	for b.Len() > 0 {
+		size, err := binary.ReadUvarint(b)
+		if err != nil {
+			t.Fatalf("reading record size failed: %v", err)
+		}
+		event := &pb.CapturedEvent{}
+		if err := proto.Unmarshal(b.Next(int(size)), event); err != nil {
+			t.Fatalf("record is not a CapturedEvent: %v", err)
+		}
+		events = append(events, event)
+	}
+	checkCapturedEvents(t, events)
+}
+
+func TestRecorder_redactsCredentials(t *testing.T) {
+	b := &bytes.Buffer{}
+	recorder, err := NewRecorder(b, CaptureFormatJSONL)
+	if err != nil {
+		t.Fatalf("NewRecorder() failed: %v", err)
+	}
+	md := metadata.Pairs(
+		AuthorizationHeader, "Bearer eyJhbGciOiJSUzI1NiJ9.e30.c2ln",
+		APIKeyHeader, "secret",
+		"cookie", "session=secret",
+		"x-goog-api-client", "gl-go/1.15")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	recorder.ObserveUnary(ctx, &pb.EchoRequest{}, &pb.EchoResponse{}, info, nil)
+
+	if strings.Contains(b.String(), "secret") || strings.Contains(b.String(), "eyJ") {
+		t.Errorf("captured event %s contains credentials", b.String())
+	}
+	event := &pb.CapturedEvent{}
+	if err := jsonpb.Unmarshal(b, event); err != nil {
+		t.Fatalf("captured event is not a CapturedEvent: %v", err)
+	}
+	want := map[string]string{
+		AuthorizationHeader: redactedValue,
+		APIKeyHeader:        redactedValue,
+		"cookie":            redactedValue,
+		"x-goog-api-client": "gl-go/1.15",
+	}
+	for _, header := range event.GetMetadata() {
+		if len(header.GetValues()) != 1 || header.GetValues()[0] != want[header.GetKey()] {
+			t.Errorf("captured %s = %q, want %q", header.GetKey(), header.GetValues(), want[header.GetKey()])
+		}
+		delete(want, header.GetKey())
+	}
+	if len(want) != 0 {
+		t.Errorf("captured metadata %v is missing %v", event.GetMetadata(), want)
+	}
+}
+
+func TestNewRecorder_unknownFormat(t *testing.T) {
+	if _, err := NewRecorder(&bytes.Buffer{}, "yaml"); err == nil {
+		t.Error("NewRecorder() succeeded with an unknown format")
+	}
+}
+
+// trailerStream is a server stream that accepts the messages and trailers
+// sent by its handler.
+type trailerStream struct {
+	grpc.ServerStream
+}
+
+func (ss *trailerStream) Context() context.Context { return context.Background() }
+
+func (ss *trailerStream) SendMsg(interface{}) error { return nil }
+
+func (ss *trailerStream) SetTrailer(metadata.MD) {}
+
+func TestRecorder_failedStream(t *testing.T) {
+	b := &bytes.Buffer{}
+	recorder, err := NewRecorder(b, CaptureFormatJSONL)
+	if err != nil {
+		t.Fatalf("NewRecorder() failed: %v", err)
+	}
+	registry := ShowcaseObserverRegistry()
+	registry.RegisterStreamResponseObserver(recorder)
+	registry.(StreamEndObserverRegistry).RegisterStreamEndObserver(recorder)
+
+	info := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Expand", IsServerStream: true}
+	expand := func(srv interface{}, ss grpc.ServerStream) error {
+		ss.SendMsg(&pb.EchoResponse{Content: "hello"})
+		ss.SetTrailer(metadata.Pairs("x-showcase-trailer", "late"))
+		return status.Error(codes.Unavailable, "expand failed")
+	}
+	if err := registry.StreamInterceptor(nil, &trailerStream{}, info, expand); status.Code(err) != codes.Unavailable {
+		t.Errorf("StreamInterceptor() = %v, want the error of the handler", err)
+	}
+
+	events, err := ReadCapture(b, CaptureFormatJSONL)
+	if err != nil {
+		t.Fatalf("ReadCapture() failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %v", len(events), events)
+	}
+	if sent := events[0]; sent.GetKind() != pb.CapturedEvent_STREAM_RESPONSE || sent.GetStatus() != nil {
+		t.Errorf("event = %v, want a sent Expand message", sent)
+	}
+	end := events[1]
+	if end.GetKind() != pb.CapturedEvent_STREAM_END || end.GetCallId() != events[0].GetCallId() {
+		t.Errorf("event = %v, want the end of the Expand stream", end)
+	}
+	if end.GetStatus().GetCode() != int32(codes.Unavailable) || end.GetStatus().GetMessage() != "expand failed" {
+		t.Errorf("event status = %v, want the Unavailable status of the handler", end.GetStatus())
+	}
+	if len(end.GetResponseTrailers()) != 1 || end.GetResponseTrailers()[0].GetKey() != "x-showcase-trailer" {
+		t.Errorf("event response trailers = %v, want the trailer set at the end of the stream", end.GetResponseTrailers())
+	}
+}
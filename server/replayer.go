@@ -0,0 +1,436 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxReplayCandidates is the number of recorded calls listed in the
+// error returned for a call that matches none of them.
+const maxReplayCandidates = 3
+
+// ReadCapture reads all the events of a capture file written by a
+// Recorder in the given format.
+func ReadCapture(r io.Reader, format string) ([]*pb.CapturedEvent, error) {
+	var events []*pb.CapturedEvent
+	br := bufio.NewReader(r)
+	switch format {
+	case CaptureFormatJSONL:
+		for line := 1; ; line++ {
+			b, err := br.ReadBytes('\n')
+			if len(bytes.TrimSpace(b)) > 0 {
+				event := &pb.CapturedEvent{}
+				if err := jsonpb.Unmarshal(bytes.NewReader(b), event); err != nil {
+					return nil, fmt.Errorf("line %d: %v", line, err)
+				}
+				events = append(events, event)
+			}
+			if err == io.EOF {
+				return events, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	case CaptureFormatProto:
+		for {
+			size, err := binary.ReadUvarint(br)
+			if err == io.EOF {
+				return events, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("event %d: %v", len(events)+1, err)
+			}
+			b := make([]byte, size)
+			if _, err := io.ReadFull(br, b); err != nil {
+				return nil, fmt.Errorf("event %d: %v", len(events)+1, err)
+			}
+			event := &pb.CapturedEvent{}
+			if err := proto.Unmarshal(b, event); err != nil {
+				return nil, fmt.Errorf("event %d: %v", len(events)+1, err)
+			}
+			events = append(events, event)
+		}
+	}
+	return nil, fmt.Errorf("unknown capture format %q: expected %q or %q", format, CaptureFormatJSONL, CaptureFormatProto)
+}
+
+// Replayer serves the calls recorded in a capture instead of the Showcase
+// services. Incoming calls are matched to recorded ones by method and by
+// request, compared in their canonical JSON form; the recorded response,
+// status, metadata and timing are then played back.
+//
+// A call recorded several times with the same request is replayed in the
+// order of the capture, the last recording being repeated once the others
+// have been served. Streaming calls are replayed once the client has sent
+// all of its messages, which means that the client of a bi-directional
+// stream must close its side of the stream before it receives anything.
+// Streams end with the status and trailers of their STREAM_END event.
+type Replayer struct {
+	mu    sync.Mutex
+	calls map[string][]*recordedCall // by recordedCall.key()
+	next  map[string]int             // index in calls of the next call to replay
+	keys  []string                   // keys of calls, in order of first appearance
+}
+
+// recordedCall holds the events of a call in a capture.
+type recordedCall struct {
+	method    string
+	requests  []string // canonical form of the requests
+	responses []*pb.CapturedEvent
+	status    *spb.Status
+	headers   metadata.MD
+	trailers  metadata.MD
+	elapsed   time.Duration // time taken by the call to complete
+}
+
+// NewReplayer returns a Replayer serving the calls recorded in events.
+func NewReplayer(events []*pb.CapturedEvent) (*Replayer, error) {
+	r := &Replayer{
+		calls: map[string][]*recordedCall{},
+		next:  map[string]int{},
+	}
+
+	var calls []*recordedCall
+	streams := map[uint64]*recordedCall{}
+	for idx, event := range events {
+		call := &recordedCall{method: event.GetMethod()}
+		if event.GetKind() != pb.CapturedEvent_UNARY && event.GetCallId() != 0 {
+			if stream, ok := streams[event.GetCallId()]; ok {
+				call = stream
+			} else {
+				streams[event.GetCallId()] = call
+				calls = append(calls, call)
+			}
+		} else {
+			calls = append(calls, call)
+		}
+		if err := call.add(event); err != nil {
+			return nil, fmt.Errorf("event %d: %v", idx+1, err)
+		}
+	}
+
+	for _, call := range calls {
+		key := call.key()
+		if _, ok := r.calls[key]; !ok {
+			r.keys = append(r.keys, key)
+		}
+		r.calls[key] = append(r.calls[key], call)
+	}
+	return r, nil
+}
+
+// add merges event into c.
+func (c *recordedCall) add(event *pb.CapturedEvent) error {
+	if event.GetRequest() != nil {
+		request, err := canonicalAny(event.GetRequest())
+		if err != nil {
+			return err
+		}
+		c.requests = append(c.requests, request)
+	}
+	if event.GetResponse() != nil {
+		c.responses = append(c.responses, event)
+	}
+	if event.GetKind() == pb.CapturedEvent_STREAM_END {
+		// The final status of a stream prevails over the errors of its
+		// messages.
+		c.status = nil
+	}
+	if event.GetStatus().GetCode() != int32(codes.OK) {
+		c.status = event.GetStatus()
+	}
+	c.headers = fromHeaders(event.GetResponseHeaders())
+	c.trailers = fromHeaders(event.GetResponseTrailers())
+	elapsed, err := ptypes.Duration(event.GetElapsed())
+	if err == nil && elapsed > c.elapsed {
+		c.elapsed = elapsed
+	}
+	return nil
+}
+
+// key identifies the calls to replay for an incoming call.
+func (c *recordedCall) key() string {
+	return replayKey(c.method, c.requests)
+}
+
+func replayKey(method string, requests []string) string {
+	return method + " " + strings.Join(requests, " ")
+}
+
+// match returns the recorded call to replay for the given incoming call.
+func (r *Replayer) match(method string, requests []string) (*recordedCall, error) {
+	key := replayKey(method, requests)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls, ok := r.calls[key]
+	if !ok {
+		return nil, r.unmatched(method, key)
+	}
+	idx := r.next[key]
+	if idx < len(calls)-1 {
+		r.next[key]++
+	}
+	return calls[idx], nil
+}
+
+// unmatched returns the error for an incoming call with the given key,
+// listing the recorded calls closest to it.
+func (r *Replayer) unmatched(method, key string) error {
+	var candidates []string
+	for _, k := range r.keys {
+		if strings.HasPrefix(k, method+" ") {
+			candidates = append(candidates, k)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = r.keys
+	}
+	if len(candidates) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "no recorded call matches %s: the capture is empty", key)
+	}
+
+	distances := make(map[string]int, len(candidates))
+	for _, k := range candidates {
+		distances[k] = editDistance(key, k)
+	}
+	sorted := append([]string(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool { return distances[sorted[i]] < distances[sorted[j]] })
+	if len(sorted) > maxReplayCandidates {
+		sorted = sorted[:maxReplayCandidates]
+	}
+	return status.Errorf(codes.FailedPrecondition, "no recorded call matches %s; nearest recorded candidates: %s", key, strings.Join(sorted, "; "))
+}
+
+// replayed returns whether calls to method are served by the replayer.
+// Calls to other services, such as health checking, reflection or the
+// Admin service, are always handled by the server itself.
+func replayed(method string) bool {
+	if strings.HasPrefix(method, adminMethodPrefix) {
+		return false
+	}
+	return strings.HasPrefix(method, "/google.showcase.") || strings.HasPrefix(method, "/google.longrunning.")
+}
+
+// UnaryInterceptor replays the recorded unary call matching the incoming
+// one. The handler is never called for replayed methods.
+func (r *Replayer) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if !replayed(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	request, err := canonical(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "replay: %v", err)
+	}
+	call, err := r.match(info.FullMethod, []string{request})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Sleep(ctx, time.Until(start.Add(call.elapsed))); err != nil {
+		return nil, err
+	}
+	if len(call.headers) > 0 {
+		grpc.SetHeader(ctx, call.headers)
+	}
+	if len(call.trailers) > 0 {
+		grpc.SetTrailer(ctx, call.trailers)
+	}
+	if call.status != nil {
+		return nil, status.ErrorProto(call.status)
+	}
+	if len(call.responses) == 0 {
+		return nil, status.Errorf(codes.Internal, "replay: no response recorded for %s", call.key())
+	}
+	return unmarshalAny(call.responses[0])
+}
+
+// StreamInterceptor replays the recorded streaming call matching the
+// messages received on the incoming stream. The handler is never called
+// for replayed methods.
+func (r *Replayer) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if !replayed(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	start := time.Now()
+	requestType, err := requestType(info.FullMethod)
+	if err != nil {
+		return status.Errorf(codes.Internal, "replay: %v", err)
+	}
+
+	var requests []string
+	for {
+		req := requestType.New().Interface()
+		if err := ss.RecvMsg(req); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		request, err := canonical(req)
+		if err != nil {
+			return status.Errorf(codes.Internal, "replay: %v", err)
+		}
+		requests = append(requests, request)
+		if !info.IsClientStream {
+			break
+		}
+	}
+	call, err := r.match(info.FullMethod, requests)
+	if err != nil {
+		return err
+	}
+
+	if len(call.headers) > 0 {
+		ss.SetHeader(call.headers)
+	}
+	if len(call.trailers) > 0 {
+		ss.SetTrailer(call.trailers)
+	}
+	for _, event := range call.responses {
+		elapsed, _ := ptypes.Duration(event.GetElapsed())
+		if err := Sleep(ss.Context(), time.Until(start.Add(elapsed))); err != nil {
+			return err
+		}
+		resp, err := unmarshalAny(event)
+		if err != nil {
+			return err
+		}
+		if err := ss.SendMsg(resp); err != nil {
+			return err
+		}
+	}
+	if err := Sleep(ss.Context(), time.Until(start.Add(call.elapsed))); err != nil {
+		return err
+	}
+	if call.status != nil {
+		return status.ErrorProto(call.status)
+	}
+	return nil
+}
+
+// requestType returns the type of the requests of the given method.
+func requestType(fullMethod string) (protoreflect.MessageType, error) {
+	name := protoreflect.FullName(strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown method %s: %v", fullMethod, err)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a method", fullMethod)
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+}
+
+// canonical returns the form of m under which requests are compared.
+func canonical(m interface{}) (string, error) {
+	message, ok := m.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("%T is not a proto message", m)
+	}
+	return (&jsonpb.Marshaler{}).MarshalToString(message)
+}
+
+// canonicalAny returns the canonical form of the message packed in any.
+func canonicalAny(any *anypb.Any) (string, error) {
+	message := &ptypes.DynamicAny{}
+	if err := ptypes.UnmarshalAny(any, message); err != nil {
+		return "", err
+	}
+	return canonical(message.Message)
+}
+
+// unmarshalAny returns the response recorded in event.
+func unmarshalAny(event *pb.CapturedEvent) (proto.Message, error) {
+	message := &ptypes.DynamicAny{}
+	if err := ptypes.UnmarshalAny(event.GetResponse(), message); err != nil {
+		return nil, status.Errorf(codes.Internal, "replay: %v", err)
+	}
+	return message.Message, nil
+}
+
+// fromHeaders is the inverse of toHeaders.
+func fromHeaders(headers []*pb.CapturedEvent_Header) metadata.MD {
+	md := metadata.MD{}
+	for _, header := range headers {
+		md[header.GetKey()] = append(md[header.GetKey()], header.GetValues()...)
+	}
+	return md
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,264 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func echoRequest(content string) *pb.EchoRequest {
+	return &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: content}}
+}
+
+func TestReadCapture(t *testing.T) {
+	for _, format := range []string{CaptureFormatJSONL, CaptureFormatProto} {
+		events, err := ReadCapture(recordCalls(t, format), format)
+		if err != nil {
+			t.Fatalf("ReadCapture(%q) failed: %v", format, err)
+		}
+		checkCapturedEvents(t, events)
+	}
+
+	if _, err := ReadCapture(strings.NewReader("{}\nnot json\n"), CaptureFormatJSONL); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ReadCapture() = %v, want an error on line 2", err)
+	}
+}
+
+func TestReplayer_UnaryInterceptor(t *testing.T) {
+	// Record the calls to replay.
+	b := &bytes.Buffer{}
+	recorder, _ := NewRecorder(b, CaptureFormatJSONL)
+	registry := ShowcaseObserverRegistry()
+	registry.RegisterUnaryObserver(recorder)
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	echo := func(ctx context.Context, req interface{}) (interface{}, error) {
+		grpc.SetTrailer(ctx, metadata.Pairs("x-showcase", "trailer"))
+		return &pb.EchoResponse{Content: req.(*pb.EchoRequest).GetContent()}, nil
+	}
+	unavailable := func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Unavailable, "retry")
+	}
+	registry.UnaryInterceptor(context.Background(), echoRequest("hello"), info, echo)
+	registry.UnaryInterceptor(context.Background(), echoRequest("retry"), info, unavailable)
+	registry.UnaryInterceptor(context.Background(), echoRequest("retry"), info, echo)
+
+	events, err := ReadCapture(b, CaptureFormatJSONL)
+	if err != nil {
+		t.Fatalf("ReadCapture() failed: %v", err)
+	}
+	replayer, err := NewReplayer(events)
+	if err != nil {
+		t.Fatalf("NewReplayer() failed: %v", err)
+	}
+
+	// Replay them through a registry, so that the trailers can be checked.
+	registry = ShowcaseObserverRegistry()
+	interceptor := ChainUnaryInterceptors(registry.UnaryInterceptor, replayer.UnaryInterceptor)
+	var trailer metadata.MD
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Errorf("handler called for %v", req)
+		return nil, nil
+	}
+	trailerObserver := &callInfoObserver{f: func(call *CallInfo) { trailer = call.Trailer() }}
+	registry.RegisterUnaryObserver(trailerObserver)
+
+	tests := []struct {
+		req     string
+		want    string
+		code    codes.Code
+		trailer bool
+	}{
+		{req: "hello", want: "hello", trailer: true},
+		{req: "retry", code: codes.Unavailable},
+		{req: "retry", want: "retry", trailer: true},
+		{req: "retry", want: "retry", trailer: true}, // The last recording is repeated.
+		{req: "hello", want: "hello", trailer: true},
+	}
+	for idx, tt := range tests {
+		trailer = nil
+		resp, err := interceptor(context.Background(), echoRequest(tt.req), info, handler)
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("%d: replay of %q returned %v, want %v", idx, tt.req, err, tt.code)
+			continue
+		}
+		if tt.code != codes.OK {
+			continue
+		}
+		if got := resp.(*pb.EchoResponse).GetContent(); got != tt.want {
+			t.Errorf("%d: replay of %q returned %q, want %q", idx, tt.req, got, tt.want)
+		}
+		if got := len(trailer.Get("x-showcase")) == 1; got != tt.trailer {
+			t.Errorf("%d: replay of %q set trailer %v", idx, tt.req, trailer)
+		}
+	}
+
+	_, err = interceptor(context.Background(), echoRequest("hullo"), info, handler)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("replay of an unrecorded call returned %v, want FailedPrecondition", err)
+	}
+	message := status.Convert(err).Message()
+	if !strings.Contains(message, `{"content":"hullo"}`) || !strings.Contains(message, `{"content":"hello"}`) {
+		t.Errorf("replay of an unrecorded call returned %q, want the request and the nearest candidate", message)
+	}
+	if strings.Index(message, `"hello"`) > strings.Index(message, `"retry"`) {
+		t.Errorf("replay of an unrecorded call returned %q, want the nearest candidate first", message)
+	}
+}
+
+func TestReplayer_UnaryInterceptor_notReplayed(t *testing.T) {
+	replayer, _ := NewReplayer(nil)
+	for _, method := range []string{"/grpc.health.v1.Health/Check", "/google.showcase.v1beta1.Admin/ResetState"} {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		called := false
+		handler := func(context.Context, interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		}
+		if _, err := replayer.UnaryInterceptor(context.Background(), nil, info, handler); err != nil || !called {
+			t.Errorf("UnaryInterceptor(%q) = %v, want the handler to be called", method, err)
+		}
+	}
+}
+
+type callInfoObserver struct {
+	f func(*CallInfo)
+}
+
+func (o *callInfoObserver) GetName() string { return "callInfoObserver" }
+
+func (o *callInfoObserver) ObserveUnary(ctx context.Context, _, _ interface{}, _ *grpc.UnaryServerInfo, _ error) {
+	call, _ := CallInfoFromContext(ctx)
+	o.f(call)
+}
+
+type replayStream struct {
+	requests []proto.Message
+	sent     []interface{}
+	trailer  metadata.MD
+
+	grpc.ServerStream
+}
+
+func (ss *replayStream) Context() context.Context { return context.Background() }
+
+func (ss *replayStream) SetHeader(metadata.MD) error { return nil }
+
+func (ss *replayStream) SetTrailer(md metadata.MD) {
+	ss.trailer = metadata.Join(ss.trailer, md)
+}
+
+func (ss *replayStream) SendMsg(m interface{}) error {
+	ss.sent = append(ss.sent, m)
+	return nil
+}
+
+func (ss *replayStream) RecvMsg(m interface{}) error {
+	if len(ss.requests) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), ss.requests[0])
+	ss.requests = ss.requests[1:]
+	return nil
+}
+
+func TestReplayer_StreamInterceptor(t *testing.T) {
+	any := func(m proto.Message) *anypb.Any {
+		a, err := ptypes.MarshalAny(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	method := "/google.showcase.v1beta1.Echo/Expand"
+	events := []*pb.CapturedEvent{
+		{CallId: 7, Method: method, Kind: pb.CapturedEvent_STREAM_REQUEST, Request: any(&pb.ExpandRequest{Content: "a b"})},
+		{CallId: 7, Method: method, Kind: pb.CapturedEvent_STREAM_RESPONSE, Response: any(&pb.EchoResponse{Content: "a"})},
+		{CallId: 7, Method: method, Kind: pb.CapturedEvent_STREAM_RESPONSE, Response: any(&pb.EchoResponse{Content: "b"})},
+	}
+	replayer, err := NewReplayer(events)
+	if err != nil {
+		t.Fatalf("NewReplayer() failed: %v", err)
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+	handler := func(interface{}, grpc.ServerStream) error {
+		t.Error("handler called")
+		return nil
+	}
+	ss := &replayStream{requests: []proto.Message{&pb.ExpandRequest{Content: "a b"}}}
+	if err := replayer.StreamInterceptor(nil, ss, info, handler); err != nil {
+		t.Fatalf("StreamInterceptor() failed: %v", err)
+	}
+	if len(ss.sent) != 2 || ss.sent[0].(*pb.EchoResponse).GetContent() != "a" || ss.sent[1].(*pb.EchoResponse).GetContent() != "b" {
+		t.Errorf("StreamInterceptor() sent %v, want the recorded responses", ss.sent)
+	}
+
+	ss = &replayStream{requests: []proto.Message{&pb.ExpandRequest{Content: "a c"}}}
+	if err := replayer.StreamInterceptor(nil, ss, info, handler); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("StreamInterceptor() = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestReplayer_StreamInterceptor_failed(t *testing.T) {
+	method := "/google.showcase.v1beta1.Echo/Expand"
+	request, _ := ptypes.MarshalAny(&pb.ExpandRequest{Content: "a b"})
+	response, _ := ptypes.MarshalAny(&pb.EchoResponse{Content: "a"})
+	trailers := []*pb.CapturedEvent_Header{{Key: "x-showcase-trailer", Values: []string{"late"}}}
+	events := []*pb.CapturedEvent{
+		{CallId: 7, Method: method, Kind: pb.CapturedEvent_STREAM_REQUEST, Request: request},
+		{CallId: 7, Method: method, Kind: pb.CapturedEvent_STREAM_RESPONSE, Response: response},
+		{
+			CallId:           7,
+			Method:           method,
+			Kind:             pb.CapturedEvent_STREAM_END,
+			Status:           status.New(codes.Unavailable, "expand failed").Proto(),
+			ResponseTrailers: trailers,
+		},
+	}
+	replayer, err := NewReplayer(events)
+	if err != nil {
+		t.Fatalf("NewReplayer() failed: %v", err)
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+	handler := func(interface{}, grpc.ServerStream) error {
+		t.Error("handler called")
+		return nil
+	}
+	ss := &replayStream{requests: []proto.Message{&pb.ExpandRequest{Content: "a b"}}}
+	err = replayer.StreamInterceptor(nil, ss, info, handler)
+	if s := status.Convert(err); s.Code() != codes.Unavailable || s.Message() != "expand failed" {
+		t.Errorf("StreamInterceptor() = %v, want the recorded Unavailable status", err)
+	}
+	if len(ss.sent) != 1 {
+		t.Errorf("StreamInterceptor() sent %v, want the recorded response", ss.sent)
+	}
+	if got := ss.trailer.Get("x-showcase-trailer"); len(got) != 1 || got[0] != "late" {
+		t.Errorf("StreamInterceptor() set trailer %v, want the recorded trailer", ss.trailer)
+	}
+}
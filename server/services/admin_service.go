@@ -0,0 +1,476 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// blurbParent matches the names of the resources that can hold blurbs.
+var blurbParent = regexp.MustCompile(`^(rooms/[^/]+|users/[^/]+/profile)$`)
+
+// NewAdminServer returns a new AdminServer managing the state of the
+// services in backend, and of its tenants if it holds any. Services that
+// are not enabled in backend are left alone.
+func NewAdminServer(backend *Backend) pb.AdminServer {
+	return &adminServerImpl{backend: backend, clock: server.GetClockInstance()}
+}
+
+type adminServerImpl struct {
+	backend *Backend
+	clock   *server.Clock
+}
+
+func (s *adminServerImpl) ResetState(ctx context.Context, in *pb.ResetStateRequest) (*empty.Empty, error) {
+	tn, err := servedTenant(ctx, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	identity, messaging, sequence, testing, operations := tn.identity, tn.messaging, tn.sequence, tn.testing, tn.operations
+
+	allBlurbs := func(string) bool { return true }
+	collection := in.GetCollection()
+	switch {
+	case collection == "":
+		if identity != nil {
+			identity.reset()
+		}
+		if messaging != nil {
+			messaging.resetRooms()
+			messaging.resetBlurbs(allBlurbs)
+		}
+		if sequence != nil {
+			sequence.reset()
+		}
+		if testing != nil {
+			testing.reset()
+		}
+		if operations != nil {
+			operations.operations.Reset()
+		}
+	case collection == "users":
+		if identity != nil {
+			identity.reset()
+		}
+		if messaging != nil {
+			messaging.resetBlurbs(func(parent string) bool { return strings.HasPrefix(parent, "users/") })
+		}
+	case collection == "rooms":
+		if messaging != nil {
+			messaging.resetRooms()
+			messaging.resetBlurbs(func(parent string) bool { return strings.HasPrefix(parent, "rooms/") })
+		}
+	case collection == "blurbs":
+		if messaging != nil {
+			messaging.resetBlurbs(allBlurbs)
+		}
+	case strings.HasSuffix(collection, "/blurbs") && blurbParent.MatchString(strings.TrimSuffix(collection, "/blurbs")):
+		if messaging != nil {
+			parent := strings.TrimSuffix(collection, "/blurbs")
+			messaging.resetBlurbs(func(p string) bool { return p == parent })
+		}
+	case collection == "sequences":
+		if sequence != nil {
+			sequence.reset()
+		}
+	case collection == "sessions":
+		if testing != nil {
+			testing.reset()
+		}
+	case collection == "operations":
+		if operations != nil {
+			operations.operations.Reset()
+		}
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Unknown collection %q: must be one of users, rooms, blurbs, {parent}/blurbs, sequences, sessions or operations.",
+			collection)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *adminServerImpl) DumpState(ctx context.Context, _ *pb.DumpStateRequest) (*pb.DumpStateResponse, error) {
+	tn, err := servedTenant(ctx, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.DumpStateResponse{}
+	if tn.identity != nil {
+		resp.Users = tn.identity.dump()
+	}
+	if tn.messaging != nil {
+		resp.Rooms, resp.Blurbs = tn.messaging.dump()
+	}
+	if tn.sequence != nil {
+		resp.Sequences, resp.SequenceReports = tn.sequence.dump()
+	}
+	if tn.testing != nil {
+		resp.Sessions = tn.testing.dump()
+	}
+	if tn.operations != nil {
+		resp.Operations = tn.operations.operations.Dump()
+	}
+	return resp, nil
+}
+
+func (s *adminServerImpl) GetStats(ctx context.Context, _ *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	tn, err := servedTenant(ctx, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetStatsResponse{}
+	if tn.identity != nil {
+		resp.Collections = append(resp.Collections, tn.identity.stats()...)
+	}
+	if tn.messaging != nil {
+		resp.Collections = append(resp.Collections, tn.messaging.stats()...)
+	}
+	if tn.sequence != nil {
+		resp.Collections = append(resp.Collections, tn.sequence.stats()...)
+	}
+	if tn.testing != nil {
+		resp.Collections = append(resp.Collections, tn.testing.stats()...)
+	}
+	if tn.operations != nil {
+		resp.Collections = append(resp.Collections, &pb.GetStatsResponse_Collection{
+			Name:          "operations",
+			ResourceCount: int64(tn.operations.operations.Len()),
+		})
+	}
+	sort.Slice(resp.Collections, func(i, j int) bool {
+		return resp.Collections[i].GetName() < resp.Collections[j].GetName()
+	})
+	return resp, nil
+}
+
+func (s *adminServerImpl) ListTenants(context.Context, *pb.ListTenantsRequest) (*pb.ListTenantsResponse, error) {
+	resp := &pb.ListTenantsResponse{Tenants: []*pb.Tenant{}}
+	if s.backend.Tenants != nil {
+		resp.Tenants = s.backend.Tenants.list()
+	}
+	return resp, nil
+}
+
+func (s *adminServerImpl) DeleteTenant(_ context.Context, in *pb.DeleteTenantRequest) (*empty.Empty, error) {
+	id, ok := tenantName(in.GetName())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid tenant name %q: must be tenants/{tenant}.", in.GetName())
+	}
+	if s.backend.Tenants == nil || !s.backend.Tenants.evict(id) {
+		return nil, status.Errorf(codes.NotFound, "Tenant %q not found.", in.GetName())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *adminServerImpl) GetClock(context.Context, *pb.GetClockRequest) (*pb.Clock, error) {
+	return s.clockProto(), nil
+}
+
+func (s *adminServerImpl) UpdateClock(_ context.Context, in *pb.UpdateClockRequest) (*pb.Clock, error) {
+	var t time.Time
+	if in.GetTime() != nil {
+		var err error
+		if t, err = ptypes.Timestamp(in.GetTime()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid time: %v", err)
+		}
+	}
+	var advance time.Duration
+	if in.GetAdvance() != nil {
+		var err error
+		if advance, err = ptypes.Duration(in.GetAdvance()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid advance: %v", err)
+		}
+		if advance < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid advance %v: must not be negative.", advance)
+		}
+	}
+
+	if in.GetRealTime() {
+		s.clock.Reset()
+	}
+	switch in.GetState() {
+	case pb.UpdateClockRequest_FROZEN:
+		s.clock.Freeze()
+	case pb.UpdateClockRequest_RUNNING:
+		s.clock.Resume()
+	}
+	if in.GetTime() != nil {
+		s.clock.Set(t)
+	}
+	if advance > 0 {
+		s.clock.Advance(advance)
+	}
+	return s.clockProto(), nil
+}
+
+// defaultTokenTTL is how long the tokens issued by IssueToken are valid
+// for, unless the request says otherwise.
+const defaultTokenTTL = time.Hour
+
+func (s *adminServerImpl) IssueToken(_ context.Context, in *pb.IssueTokenRequest) (*pb.IssueTokenResponse, error) {
+	ttl := defaultTokenTTL
+	if in.GetTtl() != nil {
+		var err error
+		if ttl, err = ptypes.Duration(in.GetTtl()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid ttl: %v", err)
+		}
+		if ttl < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid ttl %v: must not be negative.", ttl)
+		}
+	}
+	if s.backend.Authenticator == nil {
+		return nil, status.Error(codes.FailedPrecondition, "The server does not sign JWTs.")
+	}
+	token, expiry, err := s.backend.Authenticator.IssueToken(in.GetSubject(), in.GetServices(), ttl)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot issue a token: %v.", err)
+	}
+	expireTime, _ := ptypes.TimestampProto(expiry)
+	return &pb.IssueTokenResponse{AccessToken: token, ExpireTime: expireTime}, nil
+}
+
+func (s *adminServerImpl) SetServingStatus(ctx context.Context, in *pb.SetServingStatusRequest) (*empty.Empty, error) {
+	var serving healthpb.HealthCheckResponse_ServingStatus
+	switch in.GetStatus() {
+	case pb.SetServingStatusRequest_SERVING:
+		serving = healthpb.HealthCheckResponse_SERVING
+	case pb.SetServingStatusRequest_NOT_SERVING:
+		serving = healthpb.HealthCheckResponse_NOT_SERVING
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid status %s: must be SERVING or NOT_SERVING.", in.GetStatus())
+	}
+	if s.backend.HealthServer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "The server does not report its health.")
+	}
+	// The health server only knows the services it reports.
+	if _, err := s.backend.HealthServer.Check(ctx, &healthpb.HealthCheckRequest{Service: in.GetService()}); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Service %q is not served.", in.GetService())
+	}
+	s.backend.HealthServer.SetServingStatus(in.GetService(), serving)
+	return &empty.Empty{}, nil
+}
+
+func (s *adminServerImpl) clockProto() *pb.Clock {
+	now, _ := ptypes.TimestampProto(s.clock.Now())
+	return &pb.Clock{
+		Time:   now,
+		Frozen: s.clock.Frozen(),
+		Offset: ptypes.DurationProto(s.clock.Offset()),
+	}
+}
+
+// collectionStats counts the live and deleted entries of a collection.
+func collectionStats(name string, n int, deleted func(i int) bool) *pb.GetStatsResponse_Collection {
+	c := &pb.GetStatsResponse_Collection{Name: name}
+	for i := 0; i < n; i++ {
+		if deleted(i) {
+			c.DeletedCount++
+		} else {
+			c.ResourceCount++
+		}
+	}
+	return c
+}
+
+// reset deletes all the users.
+func (s *identityServerImpl) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uid.Set(0)
+	s.keys = map[string]int{}
+	s.users = nil
+}
+
+func (s *identityServerImpl) dump() []*pb.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	users := []*pb.User{}
+	for _, entry := range s.users {
+		if !entry.deleted {
+			users = append(users, entry.user)
+		}
+	}
+	return users
+}
+
+func (s *identityServerImpl) stats() []*pb.GetStatsResponse_Collection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return []*pb.GetStatsResponse_Collection{
+		collectionStats("users", len(s.users), func(i int) bool { return s.users[i].deleted }),
+	}
+}
+
+// resetRooms deletes all the rooms, but not their blurbs.
+func (s *messagingServerImpl) resetRooms() {
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.roomUID.Set(0)
+	s.roomKeys = map[string]int{}
+	s.rooms = nil
+}
+
+// resetBlurbs deletes the blurbs of the parents matched by f.
+func (s *messagingServerImpl) resetBlurbs(f func(parent string) bool) {
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+	for name, i := range s.blurbKeys {
+		if f(i.row) {
+			delete(s.blurbKeys, name)
+		}
+	}
+	for parent := range s.blurbs {
+		if f(parent) {
+			delete(s.blurbs, parent)
+			delete(s.parentUids, parent)
+		}
+	}
+}
+
+func (s *messagingServerImpl) dump() ([]*pb.Room, []*pb.Blurb) {
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+
+	rooms := []*pb.Room{}
+	for _, entry := range s.rooms {
+		if !entry.deleted {
+			rooms = append(rooms, entry.room)
+		}
+	}
+	blurbs := []*pb.Blurb{}
+	for _, parent := range s.blurbParents() {
+		for _, entry := range s.blurbs[parent] {
+			if !entry.deleted {
+				blurbs = append(blurbs, entry.blurb)
+			}
+		}
+	}
+	return rooms, blurbs
+}
+
+func (s *messagingServerImpl) stats() []*pb.GetStatsResponse_Collection {
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+
+	collections := []*pb.GetStatsResponse_Collection{
+		collectionStats("rooms", len(s.rooms), func(i int) bool { return s.rooms[i].deleted }),
+	}
+	for _, parent := range s.blurbParents() {
+		entries := s.blurbs[parent]
+		collections = append(collections,
+			collectionStats(parent+"/blurbs", len(entries), func(i int) bool { return entries[i].deleted }))
+	}
+	return collections
+}
+
+// reset deletes all the sequences and their reports.
+func (s *sequenceServerImpl) reset() {
+	s.sequences.Range(func(k, _ interface{}) bool {
+		s.sequences.Delete(k)
+		return true
+	})
+	s.reports.Range(func(k, _ interface{}) bool {
+		s.reports.Delete(k)
+		return true
+	})
+	s.uid.Set(0)
+}
+
+// dump returns the sequences and their reports in the order in which
+// the sequences were created.
+func (s *sequenceServerImpl) dump() ([]*pb.Sequence, []*pb.SequenceReport) {
+	sequences := []*pb.Sequence{}
+	reports := []*pb.SequenceReport{}
+	for id := int64(0); id < s.uid.Peek(); id++ {
+		name := fmt.Sprintf("sequences/%d", id)
+		if seq, ok := s.sequences.Load(name); ok {
+			sequences = append(sequences, seq.(*pb.Sequence))
+		}
+		if rep, ok := s.reports.Load(report(name)); ok {
+			reports = append(reports, rep.(*pb.SequenceReport))
+		}
+	}
+	return sequences, reports
+}
+
+func (s *sequenceServerImpl) stats() []*pb.GetStatsResponse_Collection {
+	sequences, _ := s.dump()
+	return []*pb.GetStatsResponse_Collection{
+		{Name: "sequences", ResourceCount: int64(len(sequences))},
+	}
+}
+
+// reset deletes all the sessions and recreates the default one.
+func (s *testingServerImpl) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unregisterTestsLocked()
+	s.uid.Set(0)
+	s.sessions, s.keys = defaultSessions(s.observerRegistry)
+}
+
+// unregisterTests stops the tests of all the sessions from observing
+// calls.
+func (s *testingServerImpl) unregisterTests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unregisterTestsLocked()
+}
+
+func (s *testingServerImpl) unregisterTestsLocked() {
+	// The tests of deleted sessions are unregistered as well, since
+	// deleting a session leaves them registered.
+	for _, entry := range s.sessions {
+		entry.session.UnregisterTests()
+	}
+}
+
+func (s *testingServerImpl) dump() []*pb.Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := []*pb.Session{}
+	for _, entry := range s.sessions {
+		if !entry.deleted {
+			sessions = append(sessions, server.SessionProto(entry.session))
+		}
+	}
+	return sessions
+}
+
+func (s *testingServerImpl) stats() []*pb.GetStatsResponse_Collection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return []*pb.GetStatsResponse_Collection{
+		collectionStats("sessions", len(s.sessions), func(i int) bool { return s.sessions[i].deleted }),
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newAdminBackend returns a backend holding two users, two rooms, a
+// blurb in each room and in the profile of the first user, a sequence,
+// a testing session and an operation.
+func newAdminBackend(t *testing.T) *Backend {
+	ctx := context.Background()
+	identity := NewIdentityServer()
+	operations := server.NewOperationStore()
+	b := &Backend{
+		IdentityServer:        identity,
+		MessagingServer:       NewMessagingServer(identity),
+		SequenceServiceServer: NewSequenceServer(),
+		TestingServer:         NewTestingServer(server.ShowcaseObserverRegistry()),
+		OperationsServer:      &operationsServerImpl{operations: operations},
+	}
+	b.AdminServer = NewAdminServer(b)
+
+	for _, name := range []string{"ekko", "misha"} {
+		if _, err := b.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{
+			User: &pb.User{DisplayName: name, Email: name + "@example.com"},
+		}); err != nil {
+			t.Fatalf("CreateUser: unexpected err %+v", err)
+		}
+	}
+	for _, name := range []string{"Living Room", "Kitchen"} {
+		if _, err := b.MessagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: name}}); err != nil {
+			t.Fatalf("CreateRoom: unexpected err %+v", err)
+		}
+	}
+	for _, parent := range []string{"rooms/0", "rooms/1", "users/0/profile"} {
+		if _, err := b.MessagingServer.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+			Parent: parent,
+			Blurb:  &pb.Blurb{User: "users/0", Content: &pb.Blurb_Text{Text: "hello"}},
+		}); err != nil {
+			t.Fatalf("CreateBlurb: unexpected err %+v", err)
+		}
+	}
+	if _, err := b.SequenceServiceServer.CreateSequence(ctx, &pb.CreateSequenceRequest{Sequence: &pb.Sequence{}}); err != nil {
+		t.Fatalf("CreateSequence: unexpected err %+v", err)
+	}
+	if _, err := b.TestingServer.CreateSession(ctx, &pb.CreateSessionRequest{Session: &pb.Session{}}); err != nil {
+		t.Fatalf("CreateSession: unexpected err %+v", err)
+	}
+	server.NewWaiter(operations).Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: durationpb.New(time.Hour)}})
+	return b
+}
+
+func counts(t *testing.T, b *Backend) map[string]int64 {
+	resp, err := b.AdminServer.GetStats(context.Background(), &pb.GetStatsRequest{})
+	if err != nil {
+		t.Fatalf("GetStats: unexpected err %+v", err)
+	}
+	counts := map[string]int64{}
+	for _, c := range resp.GetCollections() {
+		counts[c.GetName()] = c.GetResourceCount()
+	}
+	return counts
+}
+
+func TestAdmin_GetStats(t *testing.T) {
+	b := newAdminBackend(t)
+	if _, err := b.IdentityServer.DeleteUser(context.Background(), &pb.DeleteUserRequest{Name: "users/1"}); err != nil {
+		t.Fatalf("DeleteUser: unexpected err %+v", err)
+	}
+	resp, err := b.AdminServer.GetStats(context.Background(), &pb.GetStatsRequest{})
+	if err != nil {
+		t.Fatalf("GetStats: unexpected err %+v", err)
+	}
+	want := []struct {
+		name             string
+		resource, delete int64
+	}{
+		{"operations", 1, 0},
+		{"rooms", 2, 0},
+		{"rooms/0/blurbs", 1, 0},
+		{"rooms/1/blurbs", 1, 0},
+		{"sequences", 1, 0},
+		{"sessions", 2, 0},
+		{"users", 1, 1},
+		{"users/0/profile/blurbs", 1, 0},
+	}
+	got := resp.GetCollections()
+	if len(got) != len(want) {
+		t.Fatalf("GetStats: want %d collections, got %v", len(want), got)
+	}
+	for i, w := range want {
+		if got[i].GetName() != w.name || got[i].GetResourceCount() != w.resource || got[i].GetDeletedCount() != w.delete {
+			t.Errorf("GetStats: want %s with %d resources and %d deleted, got %v", w.name, w.resource, w.delete, got[i])
+		}
+	}
+}
+
+func TestAdmin_ResetState(t *testing.T) {
+	tests := []struct {
+		collection string
+		want       map[string]int64
+	}{
+		{"", map[string]int64{"users": 0, "rooms": 0, "sequences": 0, "sessions": 1, "operations": 0}},
+		{"users", map[string]int64{"users": 0, "rooms": 2, "rooms/0/blurbs": 1, "rooms/1/blurbs": 1, "sequences": 1, "sessions": 2, "operations": 1}},
+		{"rooms", map[string]int64{"users": 2, "rooms": 0, "users/0/profile/blurbs": 1, "sequences": 1, "sessions": 2, "operations": 1}},
+		{"blurbs", map[string]int64{"users": 2, "rooms": 2, "sequences": 1, "sessions": 2, "operations": 1}},
+		{"rooms/0/blurbs", map[string]int64{"users": 2, "rooms": 2, "rooms/1/blurbs": 1, "users/0/profile/blurbs": 1, "sequences": 1, "sessions": 2, "operations": 1}},
+		{"sequences", map[string]int64{"users": 2, "rooms": 2, "rooms/0/blurbs": 1, "rooms/1/blurbs": 1, "users/0/profile/blurbs": 1, "sequences": 0, "sessions": 2, "operations": 1}},
+		{"sessions", map[string]int64{"users": 2, "rooms": 2, "rooms/0/blurbs": 1, "rooms/1/blurbs": 1, "users/0/profile/blurbs": 1, "sequences": 1, "sessions": 1, "operations": 1}},
+		{"operations", map[string]int64{"users": 2, "rooms": 2, "rooms/0/blurbs": 1, "rooms/1/blurbs": 1, "users/0/profile/blurbs": 1, "sequences": 1, "sessions": 2, "operations": 0}},
+	}
+	for _, tt := range tests {
+		b := newAdminBackend(t)
+		if _, err := b.AdminServer.ResetState(context.Background(), &pb.ResetStateRequest{Collection: tt.collection}); err != nil {
+			t.Errorf("ResetState(%q): unexpected err %+v", tt.collection, err)
+			continue
+		}
+		got := counts(t, b)
+		if len(got) != len(tt.want) {
+			t.Errorf("ResetState(%q): want collections %v, got %v", tt.collection, tt.want, got)
+			continue
+		}
+		for name, n := range tt.want {
+			if got[name] != n {
+				t.Errorf("ResetState(%q): want %d resources in %s, got %d", tt.collection, n, name, got[name])
+			}
+		}
+	}
+}
+
+func TestAdmin_ResetState_names(t *testing.T) {
+	ctx := context.Background()
+	b := newAdminBackend(t)
+	if _, err := b.AdminServer.ResetState(ctx, &pb.ResetStateRequest{}); err != nil {
+		t.Fatalf("ResetState: unexpected err %+v", err)
+	}
+
+	// The previous names are available again.
+	user, err := b.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "ekko", Email: "ekko@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: unexpected err %+v", err)
+	}
+	if user.GetName() != "users/0" {
+		t.Errorf("CreateUser: want users/0, got %s", user.GetName())
+	}
+	if _, err := b.TestingServer.GetSession(ctx, &pb.GetSessionRequest{Name: "sessions/-"}); err != nil {
+		t.Errorf("GetSession: want the default session, got %v", err)
+	}
+
+	// Page tokens beyond the reset collections are rejected.
+	_, err = b.IdentityServer.ListUsers(ctx, &pb.ListUsersRequest{PageToken: b.IdentityServer.(*identityServerImpl).token.ForIndex(5)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListUsers: want InvalidArgument for stale token, got %v", err)
+	}
+}
+
+func TestAdmin_ResetState_invalid(t *testing.T) {
+	b := newAdminBackend(t)
+	for _, collection := range []string{"things", "rooms/0", "rooms/0/blurbs/1/blurbs", "users/0/blurbs"} {
+		_, err := b.AdminServer.ResetState(context.Background(), &pb.ResetStateRequest{Collection: collection})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ResetState(%q): want InvalidArgument, got %v", collection, err)
+		}
+	}
+}
+
+func TestAdmin_DumpState(t *testing.T) {
+	b := newAdminBackend(t)
+	if _, err := b.MessagingServer.DeleteBlurb(context.Background(), &pb.DeleteBlurbRequest{Name: "rooms/1/blurbs/0"}); err != nil {
+		t.Fatalf("DeleteBlurb: unexpected err %+v", err)
+	}
+	resp, err := b.AdminServer.DumpState(context.Background(), &pb.DumpStateRequest{})
+	if err != nil {
+		t.Fatalf("DumpState: unexpected err %+v", err)
+	}
+	if len(resp.GetUsers()) != 2 || len(resp.GetRooms()) != 2 || len(resp.GetSequences()) != 1 || len(resp.GetSequenceReports()) != 1 || len(resp.GetSessions()) != 2 || len(resp.GetOperations()) != 1 {
+		t.Errorf("DumpState: unexpected resources %v", resp)
+	}
+	blurbs := resp.GetBlurbs()
+	if len(blurbs) != 2 || blurbs[0].GetName() != "rooms/0/blurbs/0" || blurbs[1].GetName() != "users/0/profile/blurbs/0" {
+		t.Errorf("DumpState: want the remaining blurbs by parent, got %v", blurbs)
+	}
+}
+
+func TestAdmin_unimplemented(t *testing.T) {
+	b := &Backend{
+		IdentityServer:        &pb.UnimplementedIdentityServer{},
+		MessagingServer:       &pb.UnimplementedMessagingServer{},
+		SequenceServiceServer: &pb.UnimplementedSequenceServiceServer{},
+		TestingServer:         &pb.UnimplementedTestingServer{},
+	}
+	b.AdminServer = NewAdminServer(b)
+	if _, err := b.AdminServer.ResetState(context.Background(), &pb.ResetStateRequest{}); err != nil {
+		t.Errorf("ResetState: unexpected err %+v", err)
+	}
+	if got := counts(t, b); len(got) != 0 {
+		t.Errorf("GetStats: want no collections, got %v", got)
+	}
+}
+
+func TestAdmin_UpdateClock(t *testing.T) {
+	ctx := context.Background()
+	s := &adminServerImpl{backend: &Backend{}, clock: server.NewClock()}
+	at := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock, err := s.UpdateClock(ctx, &pb.UpdateClockRequest{
+		Time:    timestamppb.New(at),
+		State:   pb.UpdateClockRequest_FROZEN,
+		Advance: durationpb.New(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("UpdateClock: unexpected err %+v", err)
+	}
+	if want := at.Add(time.Minute); !clock.GetTime().AsTime().Equal(want) || !clock.GetFrozen() {
+		t.Errorf("UpdateClock: want frozen at %v, got %v", want, clock)
+	}
+
+	clock, err = s.UpdateClock(ctx, &pb.UpdateClockRequest{RealTime: true})
+	if err != nil {
+		t.Fatalf("UpdateClock: unexpected err %+v", err)
+	}
+	if offset := clock.GetOffset().AsDuration(); clock.GetFrozen() || offset < -time.Second || offset > time.Second {
+		t.Errorf("UpdateClock: want the real time, got %v", clock)
+	}
+
+	_, err = s.UpdateClock(ctx, &pb.UpdateClockRequest{Advance: durationpb.New(-time.Second)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateClock: want InvalidArgument for negative advance, got %v", err)
+	}
+}
+
+func TestAdmin_IssueToken(t *testing.T) {
+	ctx := context.Background()
+	s := &adminServerImpl{backend: &Backend{}, clock: server.NewClock()}
+	if _, err := s.IssueToken(ctx, &pb.IssueTokenRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("IssueToken: want FailedPrecondition without a JWT key, got %v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.backend.Authenticator = server.NewAuthenticator(server.AuthConfig{JWTKey: key})
+	resp, err := s.IssueToken(ctx, &pb.IssueTokenRequest{Subject: "alice", Services: []string{"Echo"}})
+	if err != nil {
+		t.Fatalf("IssueToken: unexpected err %+v", err)
+	}
+	if resp.GetAccessToken() == "" || !resp.GetExpireTime().AsTime().After(time.Now().Add(defaultTokenTTL-time.Minute)) {
+		t.Errorf("IssueToken: want a token valid for %v, got %v", defaultTokenTTL, resp)
+	}
+
+	_, err = s.IssueToken(ctx, &pb.IssueTokenRequest{Ttl: durationpb.New(-time.Second)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("IssueToken: want InvalidArgument for negative ttl, got %v", err)
+	}
+}
+
+func TestAdmin_SetServingStatus(t *testing.T) {
+	ctx := context.Background()
+	s := &adminServerImpl{backend: &Backend{}, clock: server.NewClock()}
+	echo := "google.showcase.v1beta1.Echo"
+	if _, err := s.SetServingStatus(ctx, &pb.SetServingStatusRequest{Service: echo, Status: pb.SetServingStatusRequest_NOT_SERVING}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SetServingStatus: want FailedPrecondition without a health server, got %v", err)
+	}
+
+	s.backend.HealthServer = health.NewServer()
+	s.backend.HealthServer.SetServingStatus(echo, healthpb.HealthCheckResponse_SERVING)
+	if _, err := s.SetServingStatus(ctx, &pb.SetServingStatusRequest{Service: echo, Status: pb.SetServingStatusRequest_NOT_SERVING}); err != nil {
+		t.Fatalf("SetServingStatus: unexpected err %+v", err)
+	}
+	resp, err := s.backend.HealthServer.Check(ctx, &healthpb.HealthCheckRequest{Service: echo})
+	if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Check: want NOT_SERVING, got %v (%v)", resp.GetStatus(), err)
+	}
+
+	tests := []struct {
+		name string
+		in   *pb.SetServingStatusRequest
+		want codes.Code
+	}{
+		{"unspecified status", &pb.SetServingStatusRequest{Service: echo}, codes.InvalidArgument},
+		{"unknown status", &pb.SetServingStatusRequest{Service: echo, Status: 7}, codes.InvalidArgument},
+		{"unknown service", &pb.SetServingStatusRequest{Service: "Echo", Status: pb.SetServingStatusRequest_SERVING}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		if _, err := s.SetServingStatus(ctx, tt.in); status.Code(err) != tt.want {
+			t.Errorf("SetServingStatus with %s: want %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
@@ -19,7 +19,6 @@ import (
 	"io"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/googleapis/gapic-showcase/server"
@@ -161,7 +160,9 @@ func (s *echoServerImpl) Wait(ctx context.Context, in *pb.WaitRequest) (*lropb.O
 
 func (s *echoServerImpl) Block(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
 	d, _ := ptypes.Duration(in.GetResponseDelay())
-	time.Sleep(d)
+	if err := server.Sleep(ctx, d); err != nil {
+		return nil, err
+	}
 	if in.GetError() != nil {
 		return nil, status.ErrorProto(in.GetError())
 	}
@@ -17,7 +17,9 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"reflect"
 	"strings"
 	"testing"
@@ -26,12 +28,14 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	durpb "github.com/golang/protobuf/ptypes/duration"
+	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 func TestEcho_success(t *testing.T) {
@@ -549,8 +553,90 @@ func TestBlockError(t *testing.T) {
 	}
 }
 
+func TestBlockDeadline(t *testing.T) {
+	server := &echoServerImpl{waiter: &mockWaiter{}}
+	in := &pb.BlockRequest{
+		ResponseDelay: &durpb.Duration{Seconds: 5},
+		Response:      &pb.BlockRequest_Success{Success: &pb.BlockResponse{Content: "late"}},
+	}
+
+	expiring, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"deadline", expiring, codes.DeadlineExceeded},
+		{"cancelled", cancelled, codes.Canceled},
+	}
+	for _, test := range tests {
+		start := time.Now()
+		_, err := server.Block(test.ctx, in)
+		if got := status.Code(err); got != test.code {
+			t.Errorf("Block(%s): got %v, want code %v", test.name, err, test.code)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Block(%s): returned after %s, want it to return when the context is done", test.name, elapsed)
+		}
+	}
+}
+
 func appendTestOutgoingMetadata(ctx context.Context, stream grpc.ServerTransportStream) context.Context {
 	ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
 	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("showcase-trailer", "show", "showcase-trailer", "case", "trailer", "trail"))
 	return ctx
 }
+
+// BenchmarkEcho_concurrent measures concurrent calls to Echo and Block
+// going through the observer registry, as in the showcase server. The
+// time per call should decrease as the parallelism increases.
+func BenchmarkEcho_concurrent(b *testing.B) {
+	lis := bufconn.Listen(1 << 20)
+	registry := server.ShowcaseObserverRegistry()
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(registry.UnaryInterceptor),
+		grpc.StreamInterceptor(registry.StreamInterceptor))
+	pb.RegisterEchoServer(s, NewEchoServer())
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure())
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	echo := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}
+	block := &pb.BlockRequest{
+		ResponseDelay: ptypes.DurationProto(time.Millisecond),
+		Response:      &pb.BlockRequest_Success{Success: &pb.BlockResponse{Content: "hello"}},
+	}
+	for _, parallelism := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("Echo/parallelism=%d", parallelism), func(b *testing.B) {
+			b.SetParallelism(parallelism)
+			b.RunParallel(func(p *testing.PB) {
+				for p.Next() {
+					if _, err := client.Echo(context.Background(), echo); err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+		b.Run(fmt.Sprintf("Block/parallelism=%d", parallelism), func(b *testing.B) {
+			b.SetParallelism(parallelism)
+			b.RunParallel(func(p *testing.PB) {
+				for p.Next() {
+					if _, err := client.Block(context.Background(), block); err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
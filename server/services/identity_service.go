@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -30,6 +31,7 @@ import (
 // NewIdentityServer returns a new instance of showcase identity server.
 func NewIdentityServer() pb.IdentityServer {
 	return &identityServerImpl{
+		nowF:  server.GetClockInstance().Now,
 		token: server.NewTokenGenerator(),
 		keys:  map[string]int{},
 	}
@@ -48,6 +50,7 @@ type ReadOnlyIdentityServer interface {
 
 type identityServerImpl struct {
 	uid   server.UniqID
+	nowF  func() time.Time
 	token server.TokenGenerator
 
 	mu    sync.Mutex
@@ -73,7 +76,7 @@ func (s *identityServerImpl) CreateUser(_ context.Context, in *pb.CreateUserRequ
 	// Assign info.
 	id := s.uid.Next()
 	name := fmt.Sprintf("users/%d", id)
-	now := ptypes.TimestampNow()
+	now, _ := ptypes.TimestampProto(s.nowF())
 
 	u.Name = name
 	u.CreateTime = now
@@ -130,13 +133,14 @@ func (s *identityServerImpl) UpdateUser(_ context.Context, in *pb.UpdateUserRequ
 		return nil, err
 	}
 	entry := s.users[i]
+	now, _ := ptypes.TimestampProto(s.nowF())
 	// Update store.
 	updated := &pb.User{
 		Name:                u.GetName(),
 		DisplayName:         u.GetDisplayName(),
 		Email:               u.GetEmail(),
 		CreateTime:          entry.user.GetCreateTime(),
-		UpdateTime:          ptypes.TimestampNow(),
+		UpdateTime:          now,
 		Age:                 entry.user.Age,
 		EnableNotifications: entry.user.EnableNotifications,
 		HeightFeet:          entry.user.HeightFeet,
@@ -189,6 +193,9 @@ func (s *identityServerImpl) ListUsers(_ context.Context, in *pb.ListUsersReques
 	if err != nil {
 		return nil, err
 	}
+	if start > len(s.users) {
+		return nil, server.InvalidTokenErr
+	}
 
 	offset := 0
 	users := []*pb.User{}
@@ -16,9 +16,9 @@ package services
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,13 +39,14 @@ import (
 func NewMessagingServer(identityServer ReadOnlyIdentityServer) MessagingServer {
 	return &messagingServerImpl{
 		identityServer: identityServer,
-		nowF:           time.Now,
+		nowF:           server.GetClockInstance().Now,
 		token:          server.NewTokenGenerator(),
 		roomKeys:       map[string]int{},
 		blurbKeys:      map[string]blurbIndex{},
 		blurbs:         map[string][]blurbEntry{},
 		parentUids:     map[string]*server.UniqID{},
 		observers:      map[string]map[string]blurbObserver{},
+		operations:     server.GetOperationStore(),
 	}
 }
 
@@ -75,6 +76,9 @@ type messagingServerImpl struct {
 	obsMu     sync.Mutex
 	obsUID    server.UniqID
 	observers map[string]map[string]blurbObserver
+
+	// operations holds the operations started by SearchBlurbs.
+	operations *server.OperationStore
 }
 
 type roomEntry struct {
@@ -125,7 +129,7 @@ func (s *messagingServerImpl) CreateRoom(ctx context.Context, in *pb.CreateRoomR
 	// Assign info.
 	id := s.roomUID.Next()
 	name := fmt.Sprintf("rooms/%d", id)
-	now := ptypes.TimestampNow()
+	now, _ := ptypes.TimestampProto(s.nowF())
 
 	r.Name = name
 	r.CreateTime = now
@@ -195,12 +199,13 @@ func (s *messagingServerImpl) UpdateRoom(ctx context.Context, in *pb.UpdateRoomR
 	}
 
 	// Update store.
+	now, _ := ptypes.TimestampProto(s.nowF())
 	updated := &pb.Room{
 		Name:        r.GetName(),
 		DisplayName: r.GetDisplayName(),
 		Description: r.GetDescription(),
 		CreateTime:  entry.room.GetCreateTime(),
-		UpdateTime:  ptypes.TimestampNow(),
+		UpdateTime:  now,
 	}
 	s.rooms[i] = roomEntry{room: updated}
 	return updated, nil
@@ -231,6 +236,9 @@ func (s *messagingServerImpl) ListRooms(ctx context.Context, in *pb.ListRoomsReq
 	if err != nil {
 		return nil, err
 	}
+	if start > len(s.rooms) {
+		return nil, server.InvalidTokenErr
+	}
 
 	offset := 0
 	rooms := []*pb.Room{}
@@ -253,6 +261,17 @@ func (s *messagingServerImpl) ListRooms(ctx context.Context, in *pb.ListRoomsReq
 	return &pb.ListRoomsResponse{Rooms: rooms, NextPageToken: nextToken}, nil
 }
 
+// blurbParents returns the parents that have held blurbs, in order. The
+// caller must hold blurbMu.
+func (s *messagingServerImpl) blurbParents() []string {
+	parents := make([]string, 0, len(s.blurbs))
+	for parent := range s.blurbs {
+		parents = append(parents, parent)
+	}
+	sort.Strings(parents)
+	return parents
+}
+
 func (s *messagingServerImpl) anyRoom(f func(*pb.Room) bool) bool {
 	for _, entry := range s.rooms {
 		if !entry.deleted && f(entry.room) {
@@ -302,7 +321,7 @@ func (s *messagingServerImpl) CreateBlurb(ctx context.Context, in *pb.CreateBlur
 
 	id := puid.Next()
 	name := fmt.Sprintf("%s/blurbs/%d", parent, id)
-	now := ptypes.TimestampNow()
+	now, _ := ptypes.TimestampProto(s.nowF())
 	switch legacyId := b.LegacyId.(type) {
 	case *pb.Blurb_LegacyRoomId:
 		name = fmt.Sprintf("%s/blurbs/legacy/%s.%d", parent, legacyId.LegacyRoomId, id)
@@ -372,7 +391,7 @@ func (s *messagingServerImpl) UpdateBlurb(ctx context.Context, in *pb.UpdateBlur
 	}
 	// Update store.
 	updated := proto.Clone(b).(*pb.Blurb)
-	updated.UpdateTime = ptypes.TimestampNow()
+	updated.UpdateTime, _ = ptypes.TimestampProto(s.nowF())
 	s.blurbs[i.row][i.col] = blurbEntry{blurb: updated}
 
 	// Call observers.
@@ -436,6 +455,9 @@ func (s *messagingServerImpl) FilteredListBlurbs(ctx context.Context, in *pb.Lis
 	if err != nil {
 		return nil, err
 	}
+	if start > len(bs) {
+		return nil, server.InvalidTokenErr
+	}
 
 	offset := 0
 	blurbs := []*pb.Blurb{}
@@ -460,29 +482,171 @@ func (s *messagingServerImpl) FilteredListBlurbs(ctx context.Context, in *pb.Lis
 	return &pb.ListBlurbsResponse{Blurbs: blurbs, NextPageToken: nextToken}, nil
 }
 
+// searchBlurbsRetryDelay is the delay after which clients are told to poll
+// SearchBlurbs operations again, unless they run for a given duration.
+const searchBlurbsRetryDelay = time.Second
+
 // This method searches through all blurbs across all rooms and profiles
 // for blurbs containing to words found in the query. Only posts that
 // contain an exact match of a queried word will be returned.
+//
+// The search is done once the operation has been polled `poll_count`
+// times with GetOperation and has run for `duration`, reporting its progress in the
+// metadata of the operation until then. The operation is named
+// "operations/messaging/search-blurbs/{id}" and keeps the request
+// server-side.
 func (s *messagingServerImpl) SearchBlurbs(ctx context.Context, in *pb.SearchBlurbsRequest) (*longrunning.Operation, error) {
 	if err := s.validateParent(in.GetParent()); err != nil {
 		return nil, err
 	}
-	reqBytes, _ := proto.Marshal(in)
-
-	name := fmt.Sprintf(
-		"operations/google.showcase.v1beta1.Messaging/SearchBlurbs/%s",
-		base64.StdEncoding.EncodeToString(reqBytes))
-	// TODO(landrito) Add randomization to the retry delay.
-	meta, _ := ptypes.MarshalAny(
-		&pb.SearchBlurbsMetadata{
-			RetryInfo: &errdetails.RetryInfo{
-				RetryDelay: ptypes.DurationProto(time.Duration(1) * time.Second),
-			},
+	search, err := newSearchBlurbsOperation(s, in, s.nowF)
+	if err != nil {
+		return nil, err
+	}
+	op := search.state(0, search.start)
+	op.Name = s.operations.NewName("messaging/search-blurbs")
+	s.operations.Register(op, search.poll)
+	return op, nil
+}
+
+// searchBlurbsOperation is the work of an operation started by
+// SearchBlurbs, which progresses each time it is polled and as time passes.
+type searchBlurbsOperation struct {
+	messagingServer MessagingServer
+	req             *pb.SearchBlurbsRequest
+	nowF            func() time.Time
+	start           time.Time
+
+	// requiredPolls and duration must both be reached for the search
+	// to be done.
+	requiredPolls int32
+	duration      time.Duration
+
+	mu    sync.Mutex
+	polls int32
+}
+
+// newSearchBlurbsOperation returns the work of the operation searching
+// the blurbs of messagingServer as requested by in, starting now as told
+// by nowF.
+func newSearchBlurbsOperation(messagingServer MessagingServer, in *pb.SearchBlurbsRequest, nowF func() time.Time) (*searchBlurbsOperation, error) {
+	if in.GetPollCount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "The field `poll_count` must not be negative.")
+	}
+	var duration time.Duration
+	if in.GetDuration() != nil {
+		var err error
+		if duration, err = ptypes.Duration(in.GetDuration()); err != nil || duration < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "The field `duration` must be a non-negative duration.")
+		}
+	}
+	if in.GetFailAtPercent() < 0 || in.GetFailAtPercent() > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "The field `fail_at_percent` must be between 0 and 100.")
+	}
+
+	requiredPolls := in.GetPollCount()
+	if requiredPolls == 0 && duration == 0 {
+		requiredPolls = 1
+	}
+	return &searchBlurbsOperation{
+		messagingServer: messagingServer,
+		req:             proto.Clone(in).(*pb.SearchBlurbsRequest),
+		nowF:            nowF,
+		start:           nowF(),
+		requiredPolls:   requiredPolls,
+		duration:        duration,
+	}, nil
+}
+
+// poll returns the state of the operation, counting a poll if polled.
+func (o *searchBlurbsOperation) poll(ctx context.Context, polled bool) *longrunning.Operation {
+	o.mu.Lock()
+	if polled {
+		o.polls++
+	}
+	polls := o.polls
+	o.mu.Unlock()
+
+	op := o.state(polls, o.nowF())
+	if op.GetDone() && op.GetResult() == nil {
+		return o.search(ctx, op)
+	}
+	return op
+}
+
+// state returns the operation after the given number of polls at the
+// given time, without the result of the search if it is done.
+func (o *searchBlurbsOperation) state(polls int32, now time.Time) *longrunning.Operation {
+	progress := 1.0
+	if o.requiredPolls > 0 {
+		progress = float64(polls) / float64(o.requiredPolls)
+	}
+	elapsed := now.Sub(o.start)
+	if o.duration > 0 {
+		if p := float64(elapsed) / float64(o.duration); p < progress {
+			progress = p
+		}
+	}
+	percent := int32(100 * progress)
+	if percent > 100 {
+		percent = 100
+	}
+	done := polls >= o.requiredPolls && elapsed >= o.duration
+
+	metadata := &pb.SearchBlurbsMetadata{ProgressPercent: percent, PollCount: polls}
+	if !done {
+		retryDelay := searchBlurbsRetryDelay
+		if o.duration > 0 {
+			remainingPolls := o.requiredPolls - polls
+			if remainingPolls < 1 {
+				remainingPolls = 1
+			}
+			if retryDelay = (o.duration - elapsed) / time.Duration(remainingPolls); retryDelay < 0 {
+				retryDelay = 0
+			}
+		}
+		metadata.RetryInfo = &errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryDelay)}
+	}
+	meta, _ := ptypes.MarshalAny(metadata)
+	op := &longrunning.Operation{Done: done, Metadata: meta}
+
+	if failAt := o.req.GetFailAtPercent(); o.req.GetError().GetCode() != int32(codes.OK) && (done || (failAt > 0 && percent >= failAt)) {
+		op.Done = true
+		op.Result = &longrunning.Operation_Error{Error: o.req.GetError()}
+	}
+	return op
+}
+
+// search sets the result of op, which is done, to the blurbs matching
+// the search.
+func (o *searchBlurbsOperation) search(ctx context.Context, op *longrunning.Operation) *longrunning.Operation {
+	in := o.req
+	listResp, err := o.messagingServer.FilteredListBlurbs(
+		ctx,
+		&pb.ListBlurbsRequest{
+			Parent:    in.GetParent(),
+			PageSize:  in.GetPageSize(),
+			PageToken: in.GetPageToken(),
 		},
-	)
-	return &longrunning.Operation{Name: name, Done: false, Metadata: meta}, nil
+		searchFilterFunc(in.GetQuery()))
+	if err != nil {
+		op.Result = &longrunning.Operation_Error{Error: status.Convert(err).Proto()}
+		return op
+	}
+
+	resp, _ := ptypes.MarshalAny(
+		&pb.SearchBlurbsResponse{
+			Blurbs:        listResp.GetBlurbs(),
+			NextPageToken: listResp.GetNextPageToken(),
+		})
+	op.Result = &longrunning.Operation_Response{Response: resp}
+	return op
 }
 
+// streamBlurbsPollInterval is how often StreamBlurbs checks whether the
+// stream has expired or failed.
+const streamBlurbsPollInterval = 10 * time.Millisecond
+
 // This returns a stream that emits the blurbs that are created for a
 // particular chat room or user profile.
 func (s *messagingServerImpl) StreamBlurbs(in *pb.StreamBlurbsRequest, stream pb.Messaging_StreamBlurbsServer) error {
@@ -511,6 +675,9 @@ func (s *messagingServerImpl) StreamBlurbs(in *pb.StreamBlurbsRequest, stream pb
 		if err := s.validateParent(parent); err != nil {
 			return err
 		}
+		if err := server.SleepRealTime(stream.Context(), streamBlurbsPollInterval); err != nil {
+			return err
+		}
 	}
 	return nil
 }
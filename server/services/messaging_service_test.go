@@ -19,7 +19,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
-	"strings"
+	"regexp"
 	"sync"
 	"testing"
 	"time"
@@ -29,6 +29,8 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/genproto/googleapis/longrunning"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -659,6 +661,7 @@ func Test_DeleteBlurb_notFound(t *testing.T) {
 
 func Test_ListBlurbs_invalidToken(t *testing.T) {
 	s := messagingServerImpl{
+		nowF:           time.Now,
 		identityServer: &mockIdentityServer{},
 		token:          server.TokenGeneratorWithSalt("salt"),
 		roomKeys:       map[string]int{},
@@ -749,26 +752,14 @@ func Test_SearchBlurbs(t *testing.T) {
 		t.Errorf("SearchBlurbs: unexpected err %+v", err)
 	}
 
-	expName := "operations/google.showcase.v1beta1.Messaging/SearchBlurbs/"
-	if !strings.HasPrefix(op.Name, expName) {
-		t.Errorf(
-			"SearchBlurbs op.Name prefex want: '%s', got: %s'",
-			expName,
-			op.Name)
-	}
-
-	reqProto := &pb.SearchBlurbsRequest{}
-	encodedBytes := strings.TrimPrefix(
-		op.Name,
-		expName)
-	bytes, _ := base64.StdEncoding.DecodeString(encodedBytes)
-	proto.Unmarshal(bytes, reqProto)
-	if !proto.Equal(reqProto, req) {
-		t.Errorf(
-			"SearchBlurbs for %q expected unmarshalled %q, got %q",
-			req,
-			req,
-			reqProto)
+	if !regexp.MustCompile(`^operations/messaging/search-blurbs/[0-9a-f]{8}-\d+$`).MatchString(op.GetName()) {
+		t.Errorf("SearchBlurbs op.Name want an opaque name, got: %s", op.GetName())
+	}
+
+	// The request is kept server-side, so the operation can be polled by name.
+	got, err := server.GetOperationStore().Get(context.Background(), op.GetName())
+	if err != nil || !got.GetDone() {
+		t.Errorf("SearchBlurbs: want a finished operation for %q, got %+v, %v", op.GetName(), got, err)
 	}
 }
 
@@ -792,12 +783,116 @@ func Test_SearchBlurbs_parentNotFound(t *testing.T) {
 	}
 }
 
+func Test_SearchBlurbs_progress(t *testing.T) {
+	now := time.Unix(100, 0)
+	nowF := func() time.Time { return now }
+	s := NewMessagingServer(&mockIdentityServer{})
+
+	// metadata returns the progress reported by op.
+	metadata := func(op *longrunning.Operation) *pb.SearchBlurbsMetadata {
+		meta := &pb.SearchBlurbsMetadata{}
+		ptypes.UnmarshalAny(op.GetMetadata(), meta)
+		return meta
+	}
+
+	search, _ := newSearchBlurbsOperation(s, &pb.SearchBlurbsRequest{
+		Parent:    "users/rumble/profile",
+		PollCount: 3,
+		Duration:  ptypes.DurationProto(10 * time.Second),
+	}, nowF)
+	tests := []struct {
+		advance    time.Duration
+		done       bool
+		progress   int32
+		retryDelay time.Duration
+	}{
+		{0, false, 0, 5 * time.Second},
+		{5 * time.Second, false, 50, 5 * time.Second},
+		{5 * time.Second, true, 100, 0},
+	}
+	for i, test := range tests {
+		now = now.Add(test.advance)
+		op := search.poll(context.Background(), true)
+		meta := metadata(op)
+		retryDelay, _ := ptypes.Duration(meta.GetRetryInfo().GetRetryDelay())
+		if op.GetDone() != test.done || meta.GetProgressPercent() != test.progress || meta.GetPollCount() != int32(i+1) {
+			t.Errorf("poll %d: want done=%v at %d%%, got %+v", i+1, test.done, test.progress, op)
+		}
+		if retryDelay != test.retryDelay {
+			t.Errorf("poll %d: want retry delay %v, got %v", i+1, test.retryDelay, retryDelay)
+		}
+	}
+
+	search, _ = newSearchBlurbsOperation(s, &pb.SearchBlurbsRequest{
+		Parent:        "users/rumble/profile",
+		PollCount:     4,
+		Error:         &spb.Status{Code: int32(codes.Aborted), Message: "aborted"},
+		FailAtPercent: 50,
+	}, nowF)
+	if op := search.poll(context.Background(), true); op.GetDone() {
+		t.Errorf("first poll: want a pending operation, got %+v", op)
+	}
+	op := search.poll(context.Background(), true)
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Aborted || metadata(op).GetProgressPercent() != 50 {
+		t.Errorf("second poll: want an operation failed at 50%%, got %+v", op)
+	}
+
+	// With a duration, the failure comes once enough time has elapsed,
+	// even without polls.
+	search, _ = newSearchBlurbsOperation(s, &pb.SearchBlurbsRequest{
+		Parent:        "users/rumble/profile",
+		Duration:      ptypes.DurationProto(10 * time.Second),
+		Error:         &spb.Status{Code: int32(codes.Aborted), Message: "aborted"},
+		FailAtPercent: 30,
+	}, nowF)
+	now = now.Add(2 * time.Second)
+	if op := search.poll(context.Background(), false); op.GetDone() || metadata(op).GetProgressPercent() != 20 {
+		t.Errorf("after 2s: want a pending operation at 20%%, got %+v", op)
+	}
+	now = now.Add(2 * time.Second)
+	op = search.poll(context.Background(), false)
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Aborted || metadata(op).GetProgressPercent() != 40 {
+		t.Errorf("after 4s: want an operation failed at 40%%, got %+v", op)
+	}
+	if meta := metadata(op); meta.GetPollCount() != 0 {
+		t.Errorf("after 4s: want no polls counted, got %d", meta.GetPollCount())
+	}
+
+	// The failure is reported when the operation would otherwise be done.
+	search, _ = newSearchBlurbsOperation(s, &pb.SearchBlurbsRequest{
+		Parent:    "users/rumble/profile",
+		PollCount: 2,
+		Error:     &spb.Status{Code: int32(codes.Internal), Message: "internal"},
+	}, nowF)
+	if op := search.poll(context.Background(), true); op.GetDone() {
+		t.Errorf("first poll: want a pending operation, got %+v", op)
+	}
+	op = search.poll(context.Background(), true)
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Internal || metadata(op).GetProgressPercent() != 100 {
+		t.Errorf("second poll: want an operation failed at 100%%, got %+v", op)
+	}
+
+	for _, req := range []*pb.SearchBlurbsRequest{
+		{Parent: "users/rumble/profile", PollCount: -1},
+		{Parent: "users/rumble/profile", Duration: ptypes.DurationProto(-time.Second)},
+		{Parent: "users/rumble/profile", FailAtPercent: 101},
+	} {
+		if _, err := s.SearchBlurbs(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SearchBlurbs(%v): want InvalidArgument, got %v", req, err)
+		}
+	}
+}
+
 type mockStreamBlurbsStream struct {
 	mu    sync.Mutex
 	resps []*pb.StreamBlurbsResponse
 	pb.Messaging_StreamBlurbsServer
 }
 
+func (m *mockStreamBlurbsStream) Context() context.Context {
+	return context.Background()
+}
+
 func (m *mockStreamBlurbsStream) Send(resp *pb.StreamBlurbsResponse) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -992,6 +1087,10 @@ type errorStreamBlurbsStream struct {
 	pb.Messaging_StreamBlurbsServer
 }
 
+func (m *errorStreamBlurbsStream) Context() context.Context {
+	return context.Background()
+}
+
 func (m *errorStreamBlurbsStream) Send(_ *pb.StreamBlurbsResponse) error {
 	return status.Error(codes.Unknown, "Error")
 }
@@ -1066,6 +1165,10 @@ type nilStreamBlurbsStream struct {
 	pb.Messaging_StreamBlurbsServer
 }
 
+func (m *nilStreamBlurbsStream) Context() context.Context {
+	return context.Background()
+}
+
 func (m *nilStreamBlurbsStream) Send(resp *pb.StreamBlurbsResponse) error {
 	return nil
 }
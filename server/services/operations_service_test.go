@@ -16,8 +16,9 @@ package services
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
+	"regexp"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,20 +32,25 @@ import (
 )
 
 func TestGetOperation_wait(t *testing.T) {
-	endTime, _ := ptypes.TimestampProto(time.Now())
+	endTime, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	waitReq := &pb.WaitRequest{End: &pb.WaitRequest_EndTime{EndTime: endTime}}
-	nameBytes, _ := proto.Marshal(waitReq)
-	req := &lropb.GetOperationRequest{
-		Name: fmt.Sprintf(
-			"operations/google.showcase.v1beta1.Echo/Wait/%s",
-			base64.StdEncoding.EncodeToString(nameBytes)),
+	started := server.GetWaiterInstance().Wait(waitReq)
+	if !regexp.MustCompile(`^operations/echo/wait/[0-9a-f]{8}-\d+$`).MatchString(started.GetName()) {
+		t.Errorf("Wait: want an opaque operation name, got %q", started.GetName())
 	}
 
-	waiter := &mockWaiter{}
-	server := &operationsServerImpl{waiter: waiter}
-	server.GetOperation(context.Background(), req)
-	if !proto.Equal(waiter.req, waitReq) {
-		t.Error("Expected echo.Wait to defer to waiter.")
+	server := NewOperationsServer()
+	op, err := server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: started.GetName()})
+	if err != nil {
+		t.Fatalf("GetOperation: unexpected err %+v", err)
+	}
+	if op.GetName() != started.GetName() || op.GetDone() {
+		t.Errorf("GetOperation: want the pending operation %q, got %+v", started.GetName(), op)
+	}
+	meta := &pb.WaitMetadata{}
+	ptypes.UnmarshalAny(op.GetMetadata(), meta)
+	if !proto.Equal(meta.GetEndTime(), endTime) {
+		t.Errorf("GetOperation: want the end time of the request %v, got %v", endTime, meta.GetEndTime())
 	}
 }
 
@@ -112,19 +118,21 @@ func TestGetOperation_searchBlurbs(t *testing.T) {
 		},
 	}
 
-	server := NewOperationsServer(wrapped)
+	server, store := newTestOperationsServer()
 
 	searchReq := &pb.SearchBlurbsRequest{
 		Query:    "woof bark",
 		Parent:   "users/rumble/profile",
 		PageSize: 2,
 	}
-	reqBytes, _ := proto.Marshal(searchReq)
-	req := &lropb.GetOperationRequest{
-		Name: fmt.Sprintf(
-			"operations/google.showcase.v1beta1.Messaging/SearchBlurbs/%s",
-			base64.StdEncoding.EncodeToString(reqBytes)),
+	search, err := newSearchBlurbsOperation(wrapped, searchReq, time.Now)
+	if err != nil {
+		t.Fatalf("newSearchBlurbsOperation: unexpected err %+v", err)
 	}
+	started := search.state(0, search.start)
+	started.Name = store.NewName("messaging/search-blurbs")
+	store.Register(started, search.poll)
+	req := &lropb.GetOperationRequest{Name: started.GetName()}
 	op, err := server.GetOperation(context.Background(), req)
 	if err != nil {
 		t.Errorf("GetOperation: unexpected err %+v", err)
@@ -153,8 +161,10 @@ func TestGetOperation_searchBlurbs(t *testing.T) {
 	if !op.Done {
 		t.Errorf("SearchBlurbs() for %q expected done=true got done=false", req)
 	}
-	if op.Metadata != nil {
-		t.Errorf("SearchBlurbs() for %q expected nil metadata, got %q", req, op.Metadata)
+	meta := &pb.SearchBlurbsMetadata{}
+	ptypes.UnmarshalAny(op.GetMetadata(), meta)
+	if meta.GetProgressPercent() != 100 || meta.GetRetryInfo() != nil {
+		t.Errorf("SearchBlurbs() for %q expected finished metadata, got %q", req, op.Metadata)
 	}
 	if op.GetError() != nil {
 		t.Errorf("SearchBlurbs() expected op.Error=nil, got %q", op.GetError())
@@ -182,106 +192,226 @@ func TestGetOperation_searchBlurbs(t *testing.T) {
 	}
 }
 
-func TestGetOperation_notFoundOperation(t *testing.T) {
-	req := &lropb.GetOperationRequest{
-		Name: "BOGUS",
+func TestGetOperation_searchBlurbsPolls(t *testing.T) {
+	now := time.Unix(100, 0)
+	nowF := func() time.Time { return now }
+	server, store := newTestOperationsServer()
+
+	search, err := newSearchBlurbsOperation(NewMessagingServer(&mockIdentityServer{}), &pb.SearchBlurbsRequest{
+		Parent:    "users/rumble/profile",
+		PollCount: 2,
+		Duration:  ptypes.DurationProto(10 * time.Second),
+	}, nowF)
+	if err != nil {
+		t.Fatalf("newSearchBlurbsOperation: unexpected err %+v", err)
 	}
-	server := NewOperationsServer(nil)
-	_, err := server.GetOperation(context.Background(), req)
-	s, _ := status.FromError(err)
-	if codes.NotFound != s.Code() {
-		t.Errorf("GetOperation with invalid name expected code=%d, got %d", codes.NotFound, s.Code())
+	started := search.state(0, search.start)
+	started.Name = store.NewName("messaging/search-blurbs")
+	store.Register(started, search.poll)
+
+	// pollCount returns the poll count and progress reported by op.
+	pollCount := func(op *lropb.Operation) (int32, int32) {
+		meta := &pb.SearchBlurbsMetadata{}
+		ptypes.UnmarshalAny(op.GetMetadata(), meta)
+		return meta.GetPollCount(), meta.GetProgressPercent()
+	}
+
+	// Listing and waiting refresh the operation without polling it.
+	now = now.Add(10 * time.Second)
+	list, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{Name: "operations"})
+	if err != nil || len(list.GetOperations()) != 1 {
+		t.Fatalf("ListOperations: want the search, got %+v, %v", list, err)
+	}
+	if polls, progress := pollCount(list.GetOperations()[0]); polls != 0 || progress != 0 || list.GetOperations()[0].GetDone() {
+		t.Errorf("ListOperations: want a pending search not polled, got %d polls at %d%%", polls, progress)
+	}
+	op, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{
+		Name:    started.GetName(),
+		Timeout: ptypes.DurationProto(30 * time.Millisecond),
+	})
+	if polls, _ := pollCount(op); err != nil || polls != 0 || op.GetDone() {
+		t.Errorf("WaitOperation: want a pending search not polled, got %+v, %v", op, err)
+	}
+
+	// Only GetOperation counts as a poll.
+	for i := int32(1); i <= 2; i++ {
+		op, err = server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: started.GetName()})
+		if polls, _ := pollCount(op); err != nil || polls != i || op.GetDone() != (i == 2) {
+			t.Errorf("GetOperation %d: want %d polls, got %+v, %v", i, i, op, err)
+		}
 	}
 }
 
-func TestGetOperation_invalidEncodedName(t *testing.T) {
-	prefixes := []string{
-		"operations/google.showcase.v1beta1.Echo/Wait",
-		"operations/google.showcase.v1beta1.Messaging/SearchBlurbs",
+func TestGetOperation_searchBlurbsDuration(t *testing.T) {
+	now := time.Unix(100, 0)
+	nowF := func() time.Time { return now }
+	server, store := newTestOperationsServer()
+
+	search, err := newSearchBlurbsOperation(NewMessagingServer(&mockIdentityServer{}), &pb.SearchBlurbsRequest{
+		Parent:    "users/rumble/profile",
+		PollCount: 1,
+		Duration:  ptypes.DurationProto(10 * time.Second),
+	}, nowF)
+	if err != nil {
+		t.Fatalf("newSearchBlurbsOperation: unexpected err %+v", err)
 	}
-	for _, p := range prefixes {
-		req := &lropb.GetOperationRequest{
-			Name: fmt.Sprintf("%s/BOGUS", p),
+	started := search.state(0, search.start)
+	started.Name = store.NewName("messaging/search-blurbs")
+	store.Register(started, search.poll)
+
+	// Enough polls are not enough until the duration has elapsed.
+	for _, advance := range []time.Duration{0, 5 * time.Second} {
+		now = now.Add(advance)
+		op, err := server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: started.GetName()})
+		if err != nil || op.GetDone() {
+			t.Errorf("GetOperation after %v: want a pending search, got %+v, %v", now.Sub(search.start), op, err)
 		}
-		server := NewOperationsServer(nil)
-		_, err := server.GetOperation(context.Background(), req)
+	}
+	now = now.Add(5 * time.Second)
+	op, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{Name: started.GetName()})
+	if err != nil || !op.GetDone() {
+		t.Errorf("WaitOperation: want the search done once polled and the duration elapsed, got %+v, %v", op, err)
+	}
+}
+
+func TestGetOperation_notFoundOperation(t *testing.T) {
+	names := []string{
+		"BOGUS",
+		"operations/echo/wait/1",
+		"operations/google.showcase.v1beta1.Echo/Wait/BOGUS",
+	}
+	for _, name := range names {
+		server, _ := newTestOperationsServer()
+		_, err := server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: name})
 		s, _ := status.FromError(err)
 		if codes.NotFound != s.Code() {
-			t.Errorf("GetOperation with invalid name expected code=%d, got %d", codes.NotFound, s.Code())
+			t.Errorf("GetOperation(%q) expected code=%d, got %d", name, codes.NotFound, s.Code())
 		}
 	}
 }
 
-func TestGetOperation_invalidMarshalledProto(t *testing.T) {
-	prefixes := []string{
-		"operations/google.showcase.v1beta1.Echo/Wait",
-		"operations/google.showcase.v1beta1.Messaging/SearchBlurbs",
-	}
-	for _, p := range prefixes {
-		name := fmt.Sprintf(
-			"%s/%s",
-			p,
-			base64.StdEncoding.EncodeToString([]byte("BOGUS")))
-		req := &lropb.GetOperationRequest{
-			Name: name,
+// newTestOperationsServer returns an OperationsServer with an empty
+// operation store, along with that store.
+func newTestOperationsServer() (lropb.OperationsServer, *server.OperationStore) {
+	store := server.NewOperationStore()
+	return &operationsServerImpl{operations: store}, store
+}
+
+// pendingOperation returns a poller for an operation that is done once
+// *done is true, counting its polls in *polls.
+func pendingOperation(done *bool, polls *int) server.OperationPoller {
+	return func(context.Context, bool) *lropb.Operation {
+		*polls++
+		if !*done {
+			return &lropb.Operation{}
 		}
-		server := NewOperationsServer(nil)
-		_, err := server.GetOperation(context.Background(), req)
-		s, _ := status.FromError(err)
-		if codes.NotFound != s.Code() {
-			t.Errorf("GetOperation with invalid name expected code=%d, got %d", codes.NotFound, s.Code())
+		resp, _ := ptypes.MarshalAny(&pb.EchoResponse{Content: "done"})
+		return &lropb.Operation{Done: true, Result: &lropb.Operation_Response{Response: resp}}
+	}
+}
+
+func TestGetOperation_registered(t *testing.T) {
+	server, store := newTestOperationsServer()
+	done, polls := false, 0
+	store.Register(&lropb.Operation{Name: "operations/test/1"}, pendingOperation(&done, &polls))
+
+	req := &lropb.GetOperationRequest{Name: "operations/test/1"}
+	op, err := server.GetOperation(context.Background(), req)
+	if err != nil || op.GetDone() || op.GetName() != req.GetName() {
+		t.Errorf("GetOperation: want a pending operation, got %+v, %v", op, err)
+	}
+	done = true
+	for i := 0; i < 2; i++ {
+		op, err = server.GetOperation(context.Background(), req)
+		if err != nil || !op.GetDone() || op.GetResponse() == nil {
+			t.Errorf("GetOperation: want a finished operation, got %+v, %v", op, err)
 		}
 	}
+	if polls != 2 {
+		t.Errorf("GetOperation: want the work to stop once done after 2 polls, got %d", polls)
+	}
 }
 
 func TestCancelOperation(t *testing.T) {
-	server := NewOperationsServer(nil)
+	server, store := newTestOperationsServer()
+	done, polls := false, 0
+	store.Register(&lropb.Operation{Name: "operations/test/1"}, pendingOperation(&done, &polls))
+
 	_, err := server.CancelOperation(context.Background(), &lropb.CancelOperationRequest{
-		Name: "a/thing",
+		Name: "operations/test/1",
 	})
 	if err != nil {
-		t.Error("CancelOperation should have been successful")
+		t.Errorf("CancelOperation: unexpected err %+v", err)
+	}
+	done = true
+	op, _ := server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: "operations/test/1"})
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Canceled {
+		t.Errorf("CancelOperation: want a CANCELLED operation, got %+v", op)
+	}
+	if polls != 0 {
+		t.Errorf("CancelOperation: want the work to stop, got %d polls", polls)
 	}
 }
 
 func TestCancelOperation_notFound(t *testing.T) {
-	server := NewOperationsServer(nil)
-	_, err := server.CancelOperation(context.Background(), &lropb.CancelOperationRequest{})
-	s, _ := status.FromError(err)
-	if codes.NotFound != s.Code() {
-		t.Errorf("CancelOperation expected code=%d, got %d", codes.NotFound, s.Code())
+	server, _ := newTestOperationsServer()
+	for _, name := range []string{"", "operations/missing"} {
+		_, err := server.CancelOperation(context.Background(), &lropb.CancelOperationRequest{Name: name})
+		if s, _ := status.FromError(err); codes.NotFound != s.Code() {
+			t.Errorf("CancelOperation(%q) expected code=%d, got %d", name, codes.NotFound, s.Code())
+		}
 	}
 }
 
 func TestServerListOperation(t *testing.T) {
-	server := NewOperationsServer(nil)
-	res, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{
-		Name: "something",
-	})
-	if err != nil {
-		t.Error("ListOperations should have been successful")
-	}
-	if len(res.Operations) != 1 {
-		t.Error("ListOperations should have a result")
+	server, store := newTestOperationsServer()
+	for i := 0; i < 5; i++ {
+		store.Register(&lropb.Operation{Name: fmt.Sprintf("operations/test/%d", i), Done: i%2 == 0}, nil)
 	}
+	store.Register(&lropb.Operation{Name: "other/1", Done: true}, nil)
 
-	res, err = server.ListOperations(context.Background(), &lropb.ListOperationsRequest{
-		Name:     "other",
-		PageSize: 8,
-	})
-	if err != nil {
-		t.Error("ListOperations should have been successful")
-	}
-	if len(res.Operations) != 8 {
-		t.Error("ListOperations should have 8 results")
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"", []string{"operations/test/0", "operations/test/1", "operations/test/2", "operations/test/3", "operations/test/4"}},
+		{"done = true", []string{"operations/test/0", "operations/test/2", "operations/test/4"}},
+		{"done=false AND name:\"test/3\"", []string{"operations/test/3"}},
+		{"name = \"operations/test/1\"", []string{"operations/test/1"}},
+	}
+	for _, test := range tests {
+		got := []string{}
+		token := ""
+		for {
+			res, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{
+				Name:      "operations",
+				Filter:    test.filter,
+				PageSize:  2,
+				PageToken: token,
+			})
+			if err != nil {
+				t.Fatalf("ListOperations(%q): unexpected err %+v", test.filter, err)
+			}
+			for _, op := range res.GetOperations() {
+				got = append(got, op.GetName())
+			}
+			if token = res.GetNextPageToken(); token == "" {
+				break
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.want) {
+			t.Errorf("ListOperations(%q): want %v, got %v", test.filter, test.want, got)
+		}
 	}
-	if len(res.NextPageToken) > 0 {
-		t.Error("ListOperations should not have more than 1 page")
+
+	_, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{Name: "operations", Filter: "metadata = 1"})
+	if s, _ := status.FromError(err); s.Code() != codes.InvalidArgument {
+		t.Errorf("ListOperations: want InvalidArgument for an unsupported filter, got %v", err)
 	}
 }
 
 func TestServerListOperation_notFound(t *testing.T) {
-	server := NewOperationsServer(nil)
+	server, _ := newTestOperationsServer()
 	_, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{})
 	s, _ := status.FromError(err)
 	if codes.NotFound != s.Code() {
@@ -290,52 +420,66 @@ func TestServerListOperation_notFound(t *testing.T) {
 }
 
 func TestServerDeleteOperation(t *testing.T) {
-	server := NewOperationsServer(nil)
+	server, store := newTestOperationsServer()
+	store.Register(&lropb.Operation{Name: "operations/test/1"}, nil)
 	_, err := server.DeleteOperation(context.Background(), &lropb.DeleteOperationRequest{
-		Name: "/delete/the/thing",
+		Name: "operations/test/1",
 	})
 	if err != nil {
 		t.Errorf("DeleteOperations should have been successful")
 	}
+	_, err = server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: "operations/test/1"})
+	if s, _ := status.FromError(err); s.Code() != codes.NotFound {
+		t.Errorf("GetOperation: want a deleted operation to be NotFound, got %v", err)
+	}
 }
 
 func TestServerDeleteOperation_notFound(t *testing.T) {
-	server := NewOperationsServer(nil)
-	_, err := server.DeleteOperation(context.Background(), &lropb.DeleteOperationRequest{})
-	s, _ := status.FromError(err)
-	if codes.NotFound != s.Code() {
-		t.Errorf("DeleteOperations expected code=%d, got %d", codes.NotFound, s.Code())
+	server, _ := newTestOperationsServer()
+	for _, name := range []string{"", "operations/missing"} {
+		_, err := server.DeleteOperation(context.Background(), &lropb.DeleteOperationRequest{Name: name})
+		if s, _ := status.FromError(err); codes.NotFound != s.Code() {
+			t.Errorf("DeleteOperation(%q) expected code=%d, got %d", name, codes.NotFound, s.Code())
+		}
 	}
 }
 
 func TestServerWaitOperation(t *testing.T) {
-	server := NewOperationsServer(nil)
-	done, notDone := false, false
-
-	for {
-		res, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{
-			Name: "some/op",
-		})
-		if err != nil {
-			t.Errorf("DeleteOperations should have been successful")
-		}
+	server, store := newTestOperationsServer()
+	done, polls := false, 0
+	var mu sync.Mutex
+	store.Register(&lropb.Operation{Name: "operations/test/1"}, func(ctx context.Context, polled bool) *lropb.Operation {
+		mu.Lock()
+		defer mu.Unlock()
+		return pendingOperation(&done, &polls)(ctx, polled)
+	})
 
-		if res.Done {
-			done = true
-		} else {
-			notDone = true
-		}
-		if done && notDone {
-			break
-		}
+	op, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{
+		Name:    "operations/test/1",
+		Timeout: ptypes.DurationProto(30 * time.Millisecond),
+	})
+	if err != nil || op.GetDone() {
+		t.Errorf("WaitOperation: want a pending operation once the timeout elapses, got %+v, %v", op, err)
+	}
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		done = true
+	}()
+	op, err = server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{Name: "operations/test/1"})
+	if err != nil || !op.GetDone() {
+		t.Errorf("WaitOperation: want a finished operation, got %+v, %v", op, err)
 	}
 }
 
 func TestServerWaitOperation_notFound(t *testing.T) {
-	server := NewOperationsServer(nil)
-	_, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{})
-	s, _ := status.FromError(err)
-	if codes.NotFound != s.Code() {
-		t.Errorf("WaitOperations expected code=%d, got %d", codes.NotFound, s.Code())
+	server, _ := newTestOperationsServer()
+	for _, name := range []string{"", "operations/missing"} {
+		_, err := server.WaitOperation(context.Background(), &lropb.WaitOperationRequest{Name: name})
+		if s, _ := status.FromError(err); codes.NotFound != s.Code() {
+			t.Errorf("WaitOperation(%q) expected code=%d, got %d", name, codes.NotFound, s.Code())
+		}
 	}
 }
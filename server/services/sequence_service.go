@@ -23,6 +23,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/grpc/codes"
@@ -32,6 +33,7 @@ import (
 // NewSequenceServer returns a new SequenceServer for the Showcase API.
 func NewSequenceServer() pb.SequenceServiceServer {
 	return &sequenceServerImpl{
+		nowF:      server.GetClockInstance().Now,
 		token:     server.NewTokenGenerator(),
 		sequences: sync.Map{},
 		reports:   sync.Map{},
@@ -40,6 +42,7 @@ func NewSequenceServer() pb.SequenceServiceServer {
 
 type sequenceServerImpl struct {
 	uid   server.UniqID
+	nowF  func() time.Time
 	token server.TokenGenerator
 
 	sequences sync.Map
@@ -63,7 +66,7 @@ func (s *sequenceServerImpl) CreateSequence(ctx context.Context, in *pb.CreateSe
 }
 
 func (s *sequenceServerImpl) AttemptSequence(ctx context.Context, in *pb.AttemptSequenceRequest) (*empty.Empty, error) {
-	received := time.Now()
+	received := s.nowF()
 	name := in.GetName()
 	if name == "" {
 		return nil, status.Errorf(
@@ -85,14 +88,17 @@ func (s *sequenceServerImpl) AttemptSequence(ctx context.Context, in *pb.Attempt
 	i, _ = s.reports.Load(report(name))
 	rep, _ := i.(*pb.SequenceReport)
 
-	// Retrieve the attempt deadline.
-	deadline, _ := ctx.Deadline()
-	dpb, err := ptypes.TimestampProto(deadline)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			err.Error(),
-		)
+	// Retrieve the attempt deadline, if the client set one.
+	var dpb *timestamp.Timestamp
+	if deadline, ok := ctx.Deadline(); ok {
+		var err error
+		dpb, err = ptypes.TimestampProto(deadline)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				err.Error(),
+			)
+		}
 	}
 
 	// Get the number of attempts, which coincides with this attempt's number.
@@ -110,8 +116,12 @@ func (s *sequenceServerImpl) AttemptSequence(ctx context.Context, in *pb.Attempt
 		st = status.New(codes.OutOfRange, "Attempt exceeded predefined responses")
 	}
 
-	// A delay of 0 returns immediately.
-	time.Sleep(delay)
+	// A delay of 0 returns immediately. An attempt whose deadline passes,
+	// or which the client cancels, during the delay is recorded with the
+	// corresponding status.
+	if err := server.Sleep(ctx, delay); err != nil {
+		st = status.Convert(err)
+	}
 
 	// Calculate the perceived delay since the last RPC attempt.
 	attDelay := &duration.Duration{}
@@ -123,7 +133,7 @@ func (s *sequenceServerImpl) AttemptSequence(ctx context.Context, in *pb.Attempt
 	}
 
 	// Clock the time that the server is sending the response
-	responseTime := time.Now()
+	responseTime := s.nowF()
 	rpb, err := ptypes.TimestampProto(responseTime)
 	if err != nil {
 		return nil, status.Errorf(
@@ -66,6 +66,40 @@ func TestSequenceEmpty(t *testing.T) {
 	}
 }
 
+func TestSequenceNoDeadline(t *testing.T) {
+	s := NewSequenceServer()
+	seq, _ := s.CreateSequence(context.Background(), &pb.CreateSequenceRequest{})
+	if _, err := s.AttemptSequence(context.Background(), &pb.AttemptSequenceRequest{Name: seq.GetName()}); err != nil {
+		t.Errorf("AttemptSequence: unexpected err %+v", err)
+	}
+
+	report, _ := s.GetSequenceReport(context.Background(), &pb.GetSequenceReportRequest{Name: report(seq.GetName())})
+	if d := report.GetAttempts()[0].GetAttemptDeadline(); d != nil {
+		t.Errorf("%s: attempt deadline = %v, want none", t.Name(), d)
+	}
+}
+
+func TestSequenceDeadlineExceeded(t *testing.T) {
+	s := NewSequenceServer()
+	seq, _ := s.CreateSequence(context.Background(), &pb.CreateSequenceRequest{
+		Sequence: &pb.Sequence{Responses: []*pb.Sequence_Response{
+			{Status: status.New(codes.OK, "").Proto(), Delay: ptypes.DurationProto(5 * time.Second)},
+		}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := s.AttemptSequence(ctx, &pb.AttemptSequenceRequest{Name: seq.GetName()})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("AttemptSequence: got %v, want DeadlineExceeded", err)
+	}
+
+	report, _ := s.GetSequenceReport(context.Background(), &pb.GetSequenceReportRequest{Name: report(seq.GetName())})
+	if got := report.GetAttempts()[0].GetStatus().GetCode(); got != int32(codes.DeadlineExceeded) {
+		t.Errorf("%s: attempt status = %v, want DeadlineExceeded", t.Name(), codes.Code(got))
+	}
+}
+
 func TestSequenceRetry(t *testing.T) {
 	s := NewSequenceServer()
 	responses := []*pb.Sequence_Response{
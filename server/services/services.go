@@ -21,6 +21,8 @@ import (
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 )
 
 // Backend contains the various service backends that will be
@@ -32,11 +34,31 @@ type Backend struct {
 	MessagingServer       pb.MessagingServer
 	SequenceServiceServer pb.SequenceServiceServer
 	TestingServer         pb.TestingServer
+	AdminServer           pb.AdminServer
+
+	// Tenants, if not nil, holds the state served by IdentityServer,
+	// MessagingServer, SequenceServiceServer and TestingServer for
+	// each tenant.
+	Tenants *Tenants
 
 	// Supporting protos
 	OperationsServer lropb.OperationsServer
+	HealthServer     *health.Server
 
 	// Other supporting data structures
 	StdLog, ErrLog   *log.Logger
 	ObserverRegistry server.GrpcObserverRegistry
+	Metrics          *server.MetricsObserver
+	Deadlines        *server.DeadlineObserver
+
+	// Authenticator, if not nil, checks the credentials of every call
+	// and issues JWTs through AdminServer.
+	Authenticator *server.Authenticator
+
+	// UnaryInterceptor and StreamInterceptor, if not nil, wrap
+	// every call regardless of the transport it was received
+	// through, so that all transports share the same server-side
+	// behavior.
+	UnaryInterceptor  grpc.UnaryServerInterceptor
+	StreamInterceptor grpc.StreamServerInterceptor
 }
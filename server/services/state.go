@@ -0,0 +1,320 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+// stateVersion identifies the layout of the snapshots written by
+// SaveState. Snapshots with a different version are rejected.
+const stateVersion = 1
+
+// StatefulServer is implemented by the backends that hold resources in
+// memory, so that their state can outlive the server process.
+type StatefulServer interface {
+	// SaveState returns a JSON snapshot of the resources held by the
+	// server.
+	SaveState() (json.RawMessage, error)
+
+	// LoadState replaces the resources held by the server with the
+	// ones in a snapshot returned by SaveState. Page tokens issued
+	// before the snapshot was taken remain valid.
+	LoadState(json.RawMessage) error
+}
+
+// stateFile is the schema of the snapshots written by SaveState. Each
+// service's state is keyed by the name of the service.
+type stateFile struct {
+	Version  int                        `json:"version"`
+	Services map[string]json.RawMessage `json:"services"`
+
+	// Tenants holds the state of the services of each tenant other
+	// than the default one, keyed by tenant ID.
+	Tenants map[string]map[string]json.RawMessage `json:"tenants,omitempty"`
+}
+
+// statefulServers returns the servers of tn that implement
+// StatefulServer, keyed by service name.
+func statefulServers(tn *tenant) map[string]StatefulServer {
+	servers := map[string]StatefulServer{}
+	if tn.identity != nil {
+		servers["Identity"] = tn.identity
+	}
+	if tn.messaging != nil {
+		servers["Messaging"] = tn.messaging
+	}
+	return servers
+}
+
+// SaveState returns a snapshot of the resources held by the servers of
+// b, and by the servers of its tenants, which can be restored with
+// LoadState.
+func SaveState(b *Backend) ([]byte, error) {
+	file := stateFile{Version: stateVersion}
+	def, err := servedTenant(context.Background(), b)
+	if err != nil {
+		return nil, err
+	}
+	if file.Services, err = saveServers(def); err != nil {
+		return nil, err
+	}
+	if b.Tenants != nil {
+		for id, tn := range b.Tenants.all() {
+			services, err := saveServers(served(b, tn))
+			if err != nil {
+				return nil, fmt.Errorf("tenant %s: %v", id, err)
+			}
+			if file.Tenants == nil {
+				file.Tenants = map[string]map[string]json.RawMessage{}
+			}
+			file.Tenants[id] = services
+		}
+	}
+	return json.MarshalIndent(file, "", "  ")
+}
+
+func saveServers(tn *tenant) (map[string]json.RawMessage, error) {
+	services := map[string]json.RawMessage{}
+	for name, s := range statefulServers(tn) {
+		state, err := s.SaveState()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		services[name] = state
+	}
+	return services, nil
+}
+
+// LoadState replaces the resources held by the servers of b with the
+// ones in a snapshot returned by SaveState. The state of services that
+// are not in the snapshot is left untouched, and the state of services
+// that b does not serve is ignored. The tenants in the snapshot are
+// created if needed, and are ignored if b holds no Tenants.
+func LoadState(b *Backend, data []byte) error {
+	file := stateFile{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+	if file.Version != stateVersion {
+		return fmt.Errorf("unsupported snapshot version %d, want %d", file.Version, stateVersion)
+	}
+	def, err := servedTenant(context.Background(), b)
+	if err != nil {
+		return err
+	}
+	if err := loadServers(def, file.Services); err != nil {
+		return err
+	}
+	if b.Tenants == nil {
+		return nil
+	}
+	for id, services := range file.Tenants {
+		if !tenantID.MatchString(id) {
+			return fmt.Errorf("invalid tenant %q", id)
+		}
+		if err := loadServers(served(b, b.Tenants.create(id)), services); err != nil {
+			return fmt.Errorf("tenant %s: %v", id, err)
+		}
+	}
+	return nil
+}
+
+func loadServers(tn *tenant, services map[string]json.RawMessage) error {
+	for name, s := range statefulServers(tn) {
+		state, ok := services[name]
+		if !ok {
+			continue
+		}
+		if err := s.LoadState(state); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// storedResource is a resource in a snapshot. Deleted resources are
+// kept so that the indices encoded in page tokens remain valid.
+type storedResource struct {
+	Resource json.RawMessage `json:"resource"`
+	Deleted  bool            `json:"deleted,omitempty"`
+}
+
+func storeResource(m proto.Message, deleted bool) (storedResource, error) {
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(m)
+	if err != nil {
+		return storedResource{}, err
+	}
+	return storedResource{Resource: json.RawMessage(s), Deleted: deleted}, nil
+}
+
+func (r storedResource) load(m proto.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(r.Resource), m)
+}
+
+type identityState struct {
+	TokenSalt string           `json:"tokenSalt"`
+	NextID    int64            `json:"nextId"`
+	Users     []storedResource `json:"users"`
+}
+
+// SaveState returns a snapshot of the users, including deleted ones.
+func (s *identityServerImpl) SaveState() (json.RawMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := identityState{TokenSalt: s.token.Salt(), NextID: s.uid.Peek()}
+	for _, entry := range s.users {
+		r, err := storeResource(entry.user, entry.deleted)
+		if err != nil {
+			return nil, err
+		}
+		state.Users = append(state.Users, r)
+	}
+	return json.Marshal(state)
+}
+
+// LoadState replaces the users with the ones in a snapshot.
+func (s *identityServerImpl) LoadState(data json.RawMessage) error {
+	state := identityState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	keys := map[string]int{}
+	users := []userEntry{}
+	for idx, r := range state.Users {
+		u := &pb.User{}
+		if err := r.load(u); err != nil {
+			return fmt.Errorf("users[%d]: %v", idx, err)
+		}
+		keys[u.GetName()] = len(users)
+		users = append(users, userEntry{user: u, deleted: r.Deleted})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uid.Set(state.NextID)
+	s.token = server.TokenGeneratorWithSalt(state.TokenSalt)
+	s.keys = keys
+	s.users = users
+	return nil
+}
+
+type messagingState struct {
+	TokenSalt  string           `json:"tokenSalt"`
+	NextRoomID int64            `json:"nextRoomId"`
+	Rooms      []storedResource `json:"rooms"`
+	Blurbs     []blurbsState    `json:"blurbs"`
+}
+
+// blurbsState holds the blurbs of a single parent.
+type blurbsState struct {
+	Parent string           `json:"parent"`
+	NextID int64            `json:"nextId"`
+	Blurbs []storedResource `json:"blurbs"`
+}
+
+// SaveState returns a snapshot of the rooms and blurbs, including
+// deleted ones. Blurb observers are not saved, so streams do not
+// survive a restart.
+func (s *messagingServerImpl) SaveState() (json.RawMessage, error) {
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+
+	state := messagingState{TokenSalt: s.token.Salt(), NextRoomID: s.roomUID.Peek()}
+	for _, entry := range s.rooms {
+		r, err := storeResource(entry.room, entry.deleted)
+		if err != nil {
+			return nil, err
+		}
+		state.Rooms = append(state.Rooms, r)
+	}
+
+	for _, parent := range s.blurbParents() {
+		bs := blurbsState{Parent: parent}
+		if puid, ok := s.parentUids[parent]; ok {
+			bs.NextID = puid.Peek()
+		}
+		for _, entry := range s.blurbs[parent] {
+			r, err := storeResource(entry.blurb, entry.deleted)
+			if err != nil {
+				return nil, err
+			}
+			bs.Blurbs = append(bs.Blurbs, r)
+		}
+		state.Blurbs = append(state.Blurbs, bs)
+	}
+	return json.Marshal(state)
+}
+
+// LoadState replaces the rooms and blurbs with the ones in a snapshot.
+func (s *messagingServerImpl) LoadState(data json.RawMessage) error {
+	state := messagingState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	roomKeys := map[string]int{}
+	rooms := []roomEntry{}
+	for idx, r := range state.Rooms {
+		room := &pb.Room{}
+		if err := r.load(room); err != nil {
+			return fmt.Errorf("rooms[%d]: %v", idx, err)
+		}
+		roomKeys[room.GetName()] = len(rooms)
+		rooms = append(rooms, roomEntry{room: room, deleted: r.Deleted})
+	}
+
+	blurbKeys := map[string]blurbIndex{}
+	blurbs := map[string][]blurbEntry{}
+	parentUids := map[string]*server.UniqID{}
+	for _, bs := range state.Blurbs {
+		entries := []blurbEntry{}
+		for idx, r := range bs.Blurbs {
+			b := &pb.Blurb{}
+			if err := r.load(b); err != nil {
+				return fmt.Errorf("blurbs of %s[%d]: %v", bs.Parent, idx, err)
+			}
+			blurbKeys[b.GetName()] = blurbIndex{row: bs.Parent, col: len(entries)}
+			entries = append(entries, blurbEntry{blurb: b, deleted: r.Deleted})
+		}
+		blurbs[bs.Parent] = entries
+		puid := &server.UniqID{}
+		puid.Set(bs.NextID)
+		parentUids[bs.Parent] = puid
+	}
+
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+	s.roomUID.Set(state.NextRoomID)
+	s.token = server.TokenGeneratorWithSalt(state.TokenSalt)
+	s.roomKeys = roomKeys
+	s.rooms = rooms
+	s.blurbKeys = blurbKeys
+	s.blurbs = blurbs
+	s.parentUids = parentUids
+	return nil
+}
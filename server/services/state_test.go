@@ -0,0 +1,145 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newStateBackend() *Backend {
+	identity := NewIdentityServer()
+	return &Backend{IdentityServer: identity, MessagingServer: NewMessagingServer(identity)}
+}
+
+func TestSaveState_roundTrip(t *testing.T) {
+	ctx := context.Background()
+	b := newStateBackend()
+
+	for _, name := range []string{"ekko", "misha", "rumble"} {
+		_, err := b.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{
+			User: &pb.User{DisplayName: name, Email: name + "@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("CreateUser: unexpected err %+v", err)
+		}
+	}
+	if _, err := b.IdentityServer.DeleteUser(ctx, &pb.DeleteUserRequest{Name: "users/1"}); err != nil {
+		t.Fatalf("DeleteUser: unexpected err %+v", err)
+	}
+	room, err := b.MessagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living Room"}})
+	if err != nil {
+		t.Fatalf("CreateRoom: unexpected err %+v", err)
+	}
+	blurb, err := b.MessagingServer.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/0", Content: &pb.Blurb_Text{Text: "hello"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateBlurb: unexpected err %+v", err)
+	}
+	page, err := b.IdentityServer.ListUsers(ctx, &pb.ListUsersRequest{PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListUsers: unexpected err %+v", err)
+	}
+
+	data, err := SaveState(b)
+	if err != nil {
+		t.Fatalf("SaveState: unexpected err %+v", err)
+	}
+	restored := newStateBackend()
+	if err := LoadState(restored, data); err != nil {
+		t.Fatalf("LoadState: unexpected err %+v", err)
+	}
+
+	// Page tokens issued before the snapshot remain valid.
+	next, err := restored.IdentityServer.ListUsers(ctx, &pb.ListUsersRequest{PageSize: 1, PageToken: page.GetNextPageToken()})
+	if err != nil {
+		t.Fatalf("ListUsers: unexpected err %+v", err)
+	}
+	if len(next.GetUsers()) != 1 || next.GetUsers()[0].GetName() != "users/2" {
+		t.Errorf("ListUsers: want users/2, got %v", next.GetUsers())
+	}
+
+	// Deleted resources stay deleted.
+	_, err = restored.IdentityServer.GetUser(ctx, &pb.GetUserRequest{Name: "users/1"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetUser: want NotFound for deleted user, got %v", err)
+	}
+
+	got, err := restored.MessagingServer.GetBlurb(ctx, &pb.GetBlurbRequest{Name: blurb.GetName()})
+	if err != nil {
+		t.Fatalf("GetBlurb: unexpected err %+v", err)
+	}
+	if !proto.Equal(got, blurb) {
+		t.Errorf("GetBlurb: want %v, got %v", blurb, got)
+	}
+
+	// New names continue from the restored ones.
+	user, err := restored.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "clover", Email: "clover@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: unexpected err %+v", err)
+	}
+	if user.GetName() != "users/3" {
+		t.Errorf("CreateUser: want users/3, got %s", user.GetName())
+	}
+	second, err := restored.MessagingServer.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/0", Content: &pb.Blurb_Text{Text: "again"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateBlurb: unexpected err %+v", err)
+	}
+	if want := room.GetName() + "/blurbs/1"; second.GetName() != want {
+		t.Errorf("CreateBlurb: want %s, got %s", want, second.GetName())
+	}
+	r, err := restored.MessagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Kitchen"}})
+	if err != nil {
+		t.Fatalf("CreateRoom: unexpected err %+v", err)
+	}
+	if r.GetName() != "rooms/1" {
+		t.Errorf("CreateRoom: want rooms/1, got %s", r.GetName())
+	}
+}
+
+func TestLoadState_invalid(t *testing.T) {
+	b := newStateBackend()
+	if err := LoadState(b, []byte(`{"version": 2}`)); err == nil || !strings.Contains(err.Error(), "version") {
+		t.Errorf("LoadState: want version error, got %v", err)
+	}
+	err := LoadState(b, []byte(`{"version": 1, "services": {"Identity": {"users": [{"resource": {"unknown": 1}}]}}}`))
+	if err == nil || !strings.Contains(err.Error(), "Identity: users[0]") {
+		t.Errorf("LoadState: want error for invalid user, got %v", err)
+	}
+}
+
+func TestLoadState_unimplemented(t *testing.T) {
+	data, err := SaveState(newStateBackend())
+	if err != nil {
+		t.Fatalf("SaveState: unexpected err %+v", err)
+	}
+	b := &Backend{IdentityServer: &pb.UnimplementedIdentityServer{}, MessagingServer: &pb.UnimplementedMessagingServer{}}
+	if err := LoadState(b, data); err != nil {
+		t.Errorf("LoadState: unexpected err %+v", err)
+	}
+}
@@ -0,0 +1,711 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantHeader is the metadata key naming the tenant of a call. Calls
+// without it are served by the default tenant.
+const TenantHeader = "x-showcase-tenant"
+
+// tenantID matches the valid values of TenantHeader.
+var tenantID = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,63}$`)
+
+// tenantOf returns the tenant named in the incoming metadata of ctx, or
+// the empty string for the default tenant.
+func tenantOf(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(TenantHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// tenant holds the servers of a single tenant.
+type tenant struct {
+	echo       *echoServerImpl
+	identity   *identityServerImpl
+	messaging  *messagingServerImpl
+	operations *operationsServerImpl
+	sequence   *sequenceServerImpl
+	testing    *testingServerImpl
+
+	created  time.Time
+	lastCall time.Time // guarded by Tenants.mu
+}
+
+func newTenant(id string, observerRegistry server.GrpcObserverRegistry) *tenant {
+	identity := NewIdentityServer().(*identityServerImpl)
+	operations := server.NewOperationStore()
+	messaging := NewMessagingServer(identity).(*messagingServerImpl)
+	messaging.operations = operations
+	now := time.Now()
+	return &tenant{
+		echo:       &echoServerImpl{waiter: server.NewWaiter(operations)},
+		identity:   identity,
+		messaging:  messaging,
+		operations: &operationsServerImpl{operations: operations},
+		sequence:   NewSequenceServer().(*sequenceServerImpl),
+		testing:    NewTestingServer(&tenantObserverRegistry{GrpcObserverRegistry: observerRegistry, id: id}).(*testingServerImpl),
+		created:    now,
+		lastCall:   now,
+	}
+}
+
+// Tenants holds the state of the Identity, Messaging, SequenceService and
+// Testing services, and the long-running operations started by the Echo
+// and Messaging services, separately for each tenant, so that clients
+// sharing a server do not observe each other's resources. A tenant is
+// created by the first call naming it.
+type Tenants struct {
+	observerRegistry server.GrpcObserverRegistry
+	def              *tenant
+
+	mu      sync.Mutex
+	tenants map[string]*tenant
+}
+
+// NewTenants returns a new Tenants holding only the default tenant. The
+// tests of the Testing sessions of every tenant are registered with
+// observerRegistry, and only observe the calls of their tenant.
+func NewTenants(observerRegistry server.GrpcObserverRegistry) *Tenants {
+	return &Tenants{
+		observerRegistry: observerRegistry,
+		def:              newTenant("", observerRegistry),
+		tenants:          map[string]*tenant{},
+	}
+}
+
+// get returns the tenant of the call with ctx, creating it if needed.
+func (t *Tenants) get(ctx context.Context) (*tenant, error) {
+	id := tenantOf(ctx)
+	if id == "" {
+		return t.def, nil
+	}
+	if !tenantID.MatchString(id) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid %s %q: must be at most 63 letters, digits, '-', '_' or '.'.",
+			TenantHeader, id)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tn := t.lookup(id)
+	tn.lastCall = time.Now()
+	return tn, nil
+}
+
+// lookup returns the tenant with the given ID, creating it if needed.
+// The caller must hold t.mu.
+func (t *Tenants) lookup(id string) *tenant {
+	tn, ok := t.tenants[id]
+	if !ok {
+		tn = newTenant(id, t.observerRegistry)
+		t.tenants[id] = tn
+	}
+	return tn
+}
+
+// all returns the tenants other than the default one, keyed by ID.
+func (t *Tenants) all() map[string]*tenant {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tenants := make(map[string]*tenant, len(t.tenants))
+	for id, tn := range t.tenants {
+		tenants[id] = tn
+	}
+	return tenants
+}
+
+// create returns the tenant with the given ID, creating it if needed.
+func (t *Tenants) create(id string) *tenant {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lookup(id)
+}
+
+func (t *Tenants) list() []*pb.Tenant {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tenants := []*pb.Tenant{}
+	for id, tn := range t.tenants {
+		created, _ := ptypes.TimestampProto(tn.created)
+		lastCall, _ := ptypes.TimestampProto(tn.lastCall)
+		tenants = append(tenants, &pb.Tenant{Name: "tenants/" + id, CreateTime: created, LastCallTime: lastCall})
+	}
+	sort.Slice(tenants, func(i, j int) bool { return tenants[i].GetName() < tenants[j].GetName() })
+	return tenants
+}
+
+// evict deletes the tenant with the given ID along with its state. It
+// returns false if there is no such tenant.
+func (t *Tenants) evict(id string) bool {
+	t.mu.Lock()
+	tn, ok := t.tenants[id]
+	delete(t.tenants, id)
+	t.mu.Unlock()
+	if ok {
+		tn.testing.unregisterTests()
+		tn.operations.operations.Reset()
+	}
+	return ok
+}
+
+// EchoServer returns an EchoServer registering the operations started by
+// each call with its tenant. The Echo service holds no other state.
+func (t *Tenants) EchoServer() pb.EchoServer {
+	return &tenantEchoServer{EchoServer: t.def.echo, tenants: t}
+}
+
+// IdentityServer returns an IdentityServer serving each call from the
+// state of its tenant.
+func (t *Tenants) IdentityServer() pb.IdentityServer {
+	return &tenantIdentityServer{tenants: t}
+}
+
+// MessagingServer returns a MessagingServer serving each call from the
+// state of its tenant.
+func (t *Tenants) MessagingServer() MessagingServer {
+	return &tenantMessagingServer{tenants: t}
+}
+
+// OperationsServer returns an OperationsServer serving each call from the
+// operations of its tenant.
+func (t *Tenants) OperationsServer() lropb.OperationsServer {
+	return &tenantOperationsServer{tenants: t}
+}
+
+// SequenceServer returns a SequenceServiceServer serving each call from
+// the state of its tenant.
+func (t *Tenants) SequenceServer() pb.SequenceServiceServer {
+	return &tenantSequenceServer{tenants: t}
+}
+
+// TestingServer returns a TestingServer serving each call from the state
+// of its tenant.
+func (t *Tenants) TestingServer() pb.TestingServer {
+	return &tenantTestingServer{tenants: t}
+}
+
+// tenantEchoServer serves every Echo call but Wait from the server of the
+// default tenant, which is no different from that of any other tenant.
+type tenantEchoServer struct {
+	pb.EchoServer
+	tenants *Tenants
+}
+
+func (s *tenantEchoServer) Wait(ctx context.Context, in *pb.WaitRequest) (*lropb.Operation, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.echo.Wait(ctx, in)
+}
+
+type tenantIdentityServer struct {
+	tenants *Tenants
+}
+
+func (s *tenantIdentityServer) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.User, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.identity.CreateUser(ctx, in)
+}
+
+func (s *tenantIdentityServer) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.identity.GetUser(ctx, in)
+}
+
+func (s *tenantIdentityServer) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.User, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.identity.UpdateUser(ctx, in)
+}
+
+func (s *tenantIdentityServer) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.identity.DeleteUser(ctx, in)
+}
+
+func (s *tenantIdentityServer) ListUsers(ctx context.Context, in *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.identity.ListUsers(ctx, in)
+}
+
+type tenantMessagingServer struct {
+	tenants *Tenants
+}
+
+func (s *tenantMessagingServer) CreateRoom(ctx context.Context, in *pb.CreateRoomRequest) (*pb.Room, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.CreateRoom(ctx, in)
+}
+
+func (s *tenantMessagingServer) GetRoom(ctx context.Context, in *pb.GetRoomRequest) (*pb.Room, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.GetRoom(ctx, in)
+}
+
+func (s *tenantMessagingServer) UpdateRoom(ctx context.Context, in *pb.UpdateRoomRequest) (*pb.Room, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.UpdateRoom(ctx, in)
+}
+
+func (s *tenantMessagingServer) DeleteRoom(ctx context.Context, in *pb.DeleteRoomRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.DeleteRoom(ctx, in)
+}
+
+func (s *tenantMessagingServer) ListRooms(ctx context.Context, in *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.ListRooms(ctx, in)
+}
+
+func (s *tenantMessagingServer) CreateBlurb(ctx context.Context, in *pb.CreateBlurbRequest) (*pb.Blurb, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.CreateBlurb(ctx, in)
+}
+
+func (s *tenantMessagingServer) GetBlurb(ctx context.Context, in *pb.GetBlurbRequest) (*pb.Blurb, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.GetBlurb(ctx, in)
+}
+
+func (s *tenantMessagingServer) UpdateBlurb(ctx context.Context, in *pb.UpdateBlurbRequest) (*pb.Blurb, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.UpdateBlurb(ctx, in)
+}
+
+func (s *tenantMessagingServer) DeleteBlurb(ctx context.Context, in *pb.DeleteBlurbRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.DeleteBlurb(ctx, in)
+}
+
+func (s *tenantMessagingServer) ListBlurbs(ctx context.Context, in *pb.ListBlurbsRequest) (*pb.ListBlurbsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.ListBlurbs(ctx, in)
+}
+
+func (s *tenantMessagingServer) FilteredListBlurbs(ctx context.Context, in *pb.ListBlurbsRequest, f func(*pb.Blurb) bool) (*pb.ListBlurbsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.FilteredListBlurbs(ctx, in, f)
+}
+
+func (s *tenantMessagingServer) SearchBlurbs(ctx context.Context, in *pb.SearchBlurbsRequest) (*lropb.Operation, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.messaging.SearchBlurbs(ctx, in)
+}
+
+func (s *tenantMessagingServer) StreamBlurbs(in *pb.StreamBlurbsRequest, stream pb.Messaging_StreamBlurbsServer) error {
+	tn, err := s.tenants.get(stream.Context())
+	if err != nil {
+		return err
+	}
+	return tn.messaging.StreamBlurbs(in, stream)
+}
+
+func (s *tenantMessagingServer) SendBlurbs(stream pb.Messaging_SendBlurbsServer) error {
+	tn, err := s.tenants.get(stream.Context())
+	if err != nil {
+		return err
+	}
+	return tn.messaging.SendBlurbs(stream)
+}
+
+func (s *tenantMessagingServer) Connect(stream pb.Messaging_ConnectServer) error {
+	tn, err := s.tenants.get(stream.Context())
+	if err != nil {
+		return err
+	}
+	return tn.messaging.Connect(stream)
+}
+
+type tenantOperationsServer struct {
+	tenants *Tenants
+}
+
+func (s *tenantOperationsServer) ListOperations(ctx context.Context, in *lropb.ListOperationsRequest) (*lropb.ListOperationsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.operations.ListOperations(ctx, in)
+}
+
+func (s *tenantOperationsServer) GetOperation(ctx context.Context, in *lropb.GetOperationRequest) (*lropb.Operation, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.operations.GetOperation(ctx, in)
+}
+
+func (s *tenantOperationsServer) DeleteOperation(ctx context.Context, in *lropb.DeleteOperationRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.operations.DeleteOperation(ctx, in)
+}
+
+func (s *tenantOperationsServer) CancelOperation(ctx context.Context, in *lropb.CancelOperationRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.operations.CancelOperation(ctx, in)
+}
+
+func (s *tenantOperationsServer) WaitOperation(ctx context.Context, in *lropb.WaitOperationRequest) (*lropb.Operation, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.operations.WaitOperation(ctx, in)
+}
+
+type tenantSequenceServer struct {
+	tenants *Tenants
+}
+
+func (s *tenantSequenceServer) CreateSequence(ctx context.Context, in *pb.CreateSequenceRequest) (*pb.Sequence, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.sequence.CreateSequence(ctx, in)
+}
+
+func (s *tenantSequenceServer) GetSequenceReport(ctx context.Context, in *pb.GetSequenceReportRequest) (*pb.SequenceReport, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.sequence.GetSequenceReport(ctx, in)
+}
+
+func (s *tenantSequenceServer) AttemptSequence(ctx context.Context, in *pb.AttemptSequenceRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.sequence.AttemptSequence(ctx, in)
+}
+
+type tenantTestingServer struct {
+	tenants *Tenants
+}
+
+func (s *tenantTestingServer) CreateSession(ctx context.Context, in *pb.CreateSessionRequest) (*pb.Session, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.CreateSession(ctx, in)
+}
+
+func (s *tenantTestingServer) GetSession(ctx context.Context, in *pb.GetSessionRequest) (*pb.Session, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.GetSession(ctx, in)
+}
+
+func (s *tenantTestingServer) ListSessions(ctx context.Context, in *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.ListSessions(ctx, in)
+}
+
+func (s *tenantTestingServer) DeleteSession(ctx context.Context, in *pb.DeleteSessionRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.DeleteSession(ctx, in)
+}
+
+func (s *tenantTestingServer) ReportSession(ctx context.Context, in *pb.ReportSessionRequest) (*pb.ReportSessionResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.ReportSession(ctx, in)
+}
+
+func (s *tenantTestingServer) ListTests(ctx context.Context, in *pb.ListTestsRequest) (*pb.ListTestsResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.ListTests(ctx, in)
+}
+
+func (s *tenantTestingServer) DeleteTest(ctx context.Context, in *pb.DeleteTestRequest) (*empty.Empty, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.DeleteTest(ctx, in)
+}
+
+func (s *tenantTestingServer) VerifyTest(ctx context.Context, in *pb.VerifyTestRequest) (*pb.VerifyTestResponse, error) {
+	tn, err := s.tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tn.testing.VerifyTest(ctx, in)
+}
+
+// tenantObserverRegistry registers the observers of the tests of a
+// tenant, so that they only observe the calls of that tenant. The names
+// of the observers of tenants other than the default one are prefixed
+// with the name of the tenant, since test names are only unique within
+// a tenant.
+type tenantObserverRegistry struct {
+	server.GrpcObserverRegistry
+	id string
+}
+
+func (r *tenantObserverRegistry) name(name string) string {
+	if r.id == "" {
+		return name
+	}
+	return "tenants/" + r.id + "/" + name
+}
+
+func (r *tenantObserverRegistry) RegisterUnaryObserver(obs server.UnaryObserver) {
+	r.GrpcObserverRegistry.RegisterUnaryObserver(&tenantUnaryObserver{UnaryObserver: obs, registry: r})
+}
+
+func (r *tenantObserverRegistry) DeleteUnaryObserver(name string) {
+	r.GrpcObserverRegistry.DeleteUnaryObserver(r.name(name))
+}
+
+func (r *tenantObserverRegistry) RegisterStreamRequestObserver(obs server.StreamRequestObserver) {
+	r.GrpcObserverRegistry.RegisterStreamRequestObserver(&tenantStreamRequestObserver{StreamRequestObserver: obs, registry: r})
+}
+
+func (r *tenantObserverRegistry) DeleteStreamRequestObserver(name string) {
+	r.GrpcObserverRegistry.DeleteStreamRequestObserver(r.name(name))
+}
+
+func (r *tenantObserverRegistry) RegisterStreamResponseObserver(obs server.StreamResponseObserver) {
+	r.GrpcObserverRegistry.RegisterStreamResponseObserver(&tenantStreamResponseObserver{StreamResponseObserver: obs, registry: r})
+}
+
+func (r *tenantObserverRegistry) DeleteStreamResponseObserver(name string) {
+	r.GrpcObserverRegistry.DeleteStreamResponseObserver(r.name(name))
+}
+
+// RegisterStreamEndObserver registers obs if the underlying registry
+// supports stream end observers, and otherwise does nothing.
+func (r *tenantObserverRegistry) RegisterStreamEndObserver(obs server.StreamEndObserver) {
+	if registry, ok := r.GrpcObserverRegistry.(server.StreamEndObserverRegistry); ok {
+		registry.RegisterStreamEndObserver(&tenantStreamEndObserver{StreamEndObserver: obs, registry: r})
+	}
+}
+
+func (r *tenantObserverRegistry) DeleteStreamEndObserver(name string) {
+	if registry, ok := r.GrpcObserverRegistry.(server.StreamEndObserverRegistry); ok {
+		registry.DeleteStreamEndObserver(r.name(name))
+	}
+}
+
+type tenantUnaryObserver struct {
+	server.UnaryObserver
+	registry *tenantObserverRegistry
+}
+
+func (o *tenantUnaryObserver) GetName() string {
+	return o.registry.name(o.UnaryObserver.GetName())
+}
+
+func (o *tenantUnaryObserver) ObserveUnary(ctx context.Context, req interface{}, resp interface{}, info *grpc.UnaryServerInfo, err error) {
+	if tenantOf(ctx) == o.registry.id {
+		o.UnaryObserver.ObserveUnary(ctx, req, resp, info, err)
+	}
+}
+
+type tenantStreamRequestObserver struct {
+	server.StreamRequestObserver
+	registry *tenantObserverRegistry
+}
+
+func (o *tenantStreamRequestObserver) GetName() string {
+	return o.registry.name(o.StreamRequestObserver.GetName())
+}
+
+func (o *tenantStreamRequestObserver) ObserveStreamRequest(ctx context.Context, req interface{}, info *grpc.StreamServerInfo, err error) {
+	if tenantOf(ctx) == o.registry.id {
+		o.StreamRequestObserver.ObserveStreamRequest(ctx, req, info, err)
+	}
+}
+
+type tenantStreamResponseObserver struct {
+	server.StreamResponseObserver
+	registry *tenantObserverRegistry
+}
+
+func (o *tenantStreamResponseObserver) GetName() string {
+	return o.registry.name(o.StreamResponseObserver.GetName())
+}
+
+func (o *tenantStreamResponseObserver) ObserveStreamResponse(ctx context.Context, resp interface{}, info *grpc.StreamServerInfo, err error) {
+	if tenantOf(ctx) == o.registry.id {
+		o.StreamResponseObserver.ObserveStreamResponse(ctx, resp, info, err)
+	}
+}
+
+type tenantStreamEndObserver struct {
+	server.StreamEndObserver
+	registry *tenantObserverRegistry
+}
+
+func (o *tenantStreamEndObserver) GetName() string {
+	return o.registry.name(o.StreamEndObserver.GetName())
+}
+
+func (o *tenantStreamEndObserver) ObserveStreamEnd(ctx context.Context, info *grpc.StreamServerInfo, err error) {
+	if tenantOf(ctx) == o.registry.id {
+		o.StreamEndObserver.ObserveStreamEnd(ctx, info, err)
+	}
+}
+
+// servedTenant returns the servers of b serving the tenant of ctx. The
+// servers of the services that b does not serve are left nil.
+func servedTenant(ctx context.Context, b *Backend) (*tenant, error) {
+	if b.Tenants == nil {
+		return served(b, &tenant{}), nil
+	}
+	tn, err := b.Tenants.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return served(b, tn), nil
+}
+
+// served returns the servers of tn for the services served by b through
+// b.Tenants, along with the servers of b that serve a single tenant.
+func served(b *Backend, tn *tenant) *tenant {
+	s := &tenant{}
+	switch srv := b.IdentityServer.(type) {
+	case *identityServerImpl:
+		s.identity = srv
+	case *tenantIdentityServer:
+		s.identity = tn.identity
+	}
+	switch srv := b.MessagingServer.(type) {
+	case *messagingServerImpl:
+		s.messaging = srv
+	case *tenantMessagingServer:
+		s.messaging = tn.messaging
+	}
+	switch srv := b.OperationsServer.(type) {
+	case *operationsServerImpl:
+		s.operations = srv
+	case *tenantOperationsServer:
+		s.operations = tn.operations
+	}
+	switch srv := b.SequenceServiceServer.(type) {
+	case *sequenceServerImpl:
+		s.sequence = srv
+	case *tenantSequenceServer:
+		s.sequence = tn.sequence
+	}
+	switch srv := b.TestingServer.(type) {
+	case *testingServerImpl:
+		s.testing = srv
+	case *tenantTestingServer:
+		s.testing = tn.testing
+	}
+	return s
+}
+
+// tenantName returns the ID of the tenant with the given resource name.
+func tenantName(name string) (string, bool) {
+	id := strings.TrimPrefix(name, "tenants/")
+	return id, id != name && tenantID.MatchString(id)
+}
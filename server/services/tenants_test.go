@@ -0,0 +1,279 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTenantBackend() *Backend {
+	tenants := NewTenants(server.ShowcaseObserverRegistry())
+	b := &Backend{
+		EchoServer:            tenants.EchoServer(),
+		IdentityServer:        tenants.IdentityServer(),
+		MessagingServer:       tenants.MessagingServer(),
+		SequenceServiceServer: tenants.SequenceServer(),
+		TestingServer:         tenants.TestingServer(),
+		OperationsServer:      tenants.OperationsServer(),
+		Tenants:               tenants,
+	}
+	b.AdminServer = NewAdminServer(b)
+	return b
+}
+
+func tenantContext(id string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantHeader, id))
+}
+
+func TestTenants_isolated(t *testing.T) {
+	b := newTenantBackend()
+	for _, ctx := range []context.Context{context.Background(), tenantContext("go"), tenantContext("java")} {
+		user, err := b.IdentityServer.CreateUser(ctx, &pb.CreateUserRequest{
+			User: &pb.User{DisplayName: "ekko", Email: "ekko@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("CreateUser: unexpected err %+v", err)
+		}
+		if user.GetName() != "users/0" {
+			t.Errorf("CreateUser: want users/0 in every tenant, got %s", user.GetName())
+		}
+	}
+
+	ctx := tenantContext("go")
+	if _, err := b.MessagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living Room"}}); err != nil {
+		t.Fatalf("CreateRoom: unexpected err %+v", err)
+	}
+	if _, err := b.SequenceServiceServer.CreateSequence(ctx, &pb.CreateSequenceRequest{Sequence: &pb.Sequence{}}); err != nil {
+		t.Fatalf("CreateSequence: unexpected err %+v", err)
+	}
+	if _, err := b.TestingServer.CreateSession(ctx, &pb.CreateSessionRequest{Session: &pb.Session{}}); err != nil {
+		t.Fatalf("CreateSession: unexpected err %+v", err)
+	}
+	for id, want := range map[string]int{"go": 1, "java": 0} {
+		ctx := tenantContext(id)
+		rooms, err := b.MessagingServer.ListRooms(ctx, &pb.ListRoomsRequest{})
+		if err != nil {
+			t.Fatalf("ListRooms: unexpected err %+v", err)
+		}
+		if len(rooms.GetRooms()) != want {
+			t.Errorf("ListRooms: want %d rooms in tenant %s, got %v", want, id, rooms.GetRooms())
+		}
+		sessions, err := b.TestingServer.ListSessions(ctx, &pb.ListSessionsRequest{PageSize: 10})
+		if err != nil {
+			t.Fatalf("ListSessions: unexpected err %+v", err)
+		}
+		if len(sessions.GetSessions()) != want+1 {
+			t.Errorf("ListSessions: want %d sessions in tenant %s, got %v", want+1, id, sessions.GetSessions())
+		}
+	}
+
+	_, err := b.IdentityServer.GetUser(tenantContext("not/valid"), &pb.GetUserRequest{Name: "users/0"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetUser: want InvalidArgument for invalid tenant, got %v", err)
+	}
+}
+
+func TestTenants_admin(t *testing.T) {
+	b := newTenantBackend()
+	for _, id := range []string{"java", "go"} {
+		if _, err := b.IdentityServer.CreateUser(tenantContext(id), &pb.CreateUserRequest{
+			User: &pb.User{DisplayName: "ekko", Email: "ekko@example.com"},
+		}); err != nil {
+			t.Fatalf("CreateUser: unexpected err %+v", err)
+		}
+	}
+
+	// The admin calls that manage state apply to the tenant of the call.
+	if _, err := b.AdminServer.ResetState(tenantContext("go"), &pb.ResetStateRequest{Collection: "users"}); err != nil {
+		t.Fatalf("ResetState: unexpected err %+v", err)
+	}
+	for id, want := range map[string]int64{"go": 0, "java": 1} {
+		stats, err := b.AdminServer.GetStats(tenantContext(id), &pb.GetStatsRequest{})
+		if err != nil {
+			t.Fatalf("GetStats: unexpected err %+v", err)
+		}
+		for _, c := range stats.GetCollections() {
+			if c.GetName() == "users" && c.GetResourceCount() != want {
+				t.Errorf("GetStats: want %d users in tenant %s, got %d", want, id, c.GetResourceCount())
+			}
+		}
+	}
+
+	resp, err := b.AdminServer.ListTenants(context.Background(), &pb.ListTenantsRequest{})
+	if err != nil {
+		t.Fatalf("ListTenants: unexpected err %+v", err)
+	}
+	tenants := resp.GetTenants()
+	if len(tenants) != 2 || tenants[0].GetName() != "tenants/go" || tenants[1].GetName() != "tenants/java" {
+		t.Fatalf("ListTenants: want tenants/go and tenants/java, got %v", tenants)
+	}
+	if tenants[0].GetCreateTime() == nil || tenants[0].GetLastCallTime() == nil {
+		t.Errorf("ListTenants: want create and last call times, got %v", tenants[0])
+	}
+
+	if _, err := b.AdminServer.DeleteTenant(context.Background(), &pb.DeleteTenantRequest{Name: "tenants/java"}); err != nil {
+		t.Fatalf("DeleteTenant: unexpected err %+v", err)
+	}
+	_, err = b.AdminServer.DeleteTenant(context.Background(), &pb.DeleteTenantRequest{Name: "tenants/java"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteTenant: want NotFound for deleted tenant, got %v", err)
+	}
+	_, err = b.AdminServer.DeleteTenant(context.Background(), &pb.DeleteTenantRequest{Name: "java"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DeleteTenant: want InvalidArgument for invalid name, got %v", err)
+	}
+
+	// A deleted tenant starts over once it is named again.
+	users, err := b.IdentityServer.ListUsers(tenantContext("java"), &pb.ListUsersRequest{})
+	if err != nil {
+		t.Fatalf("ListUsers: unexpected err %+v", err)
+	}
+	if len(users.GetUsers()) != 0 {
+		t.Errorf("ListUsers: want no users in recreated tenant, got %v", users.GetUsers())
+	}
+}
+
+func TestTenants_operations(t *testing.T) {
+	b := newTenantBackend()
+	wait := &pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: durationpb.New(time.Hour)}}
+	op, err := b.EchoServer.Wait(context.Background(), wait)
+	if err != nil {
+		t.Fatalf("Wait: unexpected err %+v", err)
+	}
+	if _, err := b.MessagingServer.CreateRoom(tenantContext("go"), &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living Room"}}); err != nil {
+		t.Fatalf("CreateRoom: unexpected err %+v", err)
+	}
+	if _, err := b.MessagingServer.SearchBlurbs(tenantContext("go"), &pb.SearchBlurbsRequest{Parent: "rooms/0", Query: "hello"}); err != nil {
+		t.Fatalf("SearchBlurbs: unexpected err %+v", err)
+	}
+
+	// Other tenants can neither see nor change the operation.
+	other := tenantContext("other")
+	if _, err := b.OperationsServer.GetOperation(other, &lropb.GetOperationRequest{Name: op.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetOperation: want NotFound from another tenant, got %v", err)
+	}
+	if _, err := b.OperationsServer.CancelOperation(other, &lropb.CancelOperationRequest{Name: op.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelOperation: want NotFound from another tenant, got %v", err)
+	}
+	if _, err := b.OperationsServer.DeleteOperation(other, &lropb.DeleteOperationRequest{Name: op.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteOperation: want NotFound from another tenant, got %v", err)
+	}
+	for id, want := range map[string]int{"": 1, "go": 1, "other": 0} {
+		ctx := tenantContext(id)
+		if id == "" {
+			ctx = context.Background()
+		}
+		resp, err := b.OperationsServer.ListOperations(ctx, &lropb.ListOperationsRequest{Name: "operations"})
+		if err != nil {
+			t.Fatalf("ListOperations: unexpected err %+v", err)
+		}
+		if len(resp.GetOperations()) != want {
+			t.Errorf("ListOperations: want %d operations in tenant %q, got %v", want, id, resp.GetOperations())
+		}
+	}
+	if got, err := b.OperationsServer.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: op.GetName()}); err != nil || got.GetDone() {
+		t.Errorf("GetOperation: want the pending operation in the default tenant, got %v, %v", got, err)
+	}
+
+	// The admin service manages the operations of the tenant of the call.
+	if _, err := b.AdminServer.ResetState(tenantContext("go"), &pb.ResetStateRequest{Collection: "operations"}); err != nil {
+		t.Fatalf("ResetState: unexpected err %+v", err)
+	}
+	for id, want := range map[string]int{"": 1, "go": 0} {
+		ctx := tenantContext(id)
+		if id == "" {
+			ctx = context.Background()
+		}
+		dump, err := b.AdminServer.DumpState(ctx, &pb.DumpStateRequest{})
+		if err != nil {
+			t.Fatalf("DumpState: unexpected err %+v", err)
+		}
+		if len(dump.GetOperations()) != want {
+			t.Errorf("DumpState: want %d operations in tenant %q, got %v", want, id, dump.GetOperations())
+		}
+	}
+}
+
+type countingObserver struct {
+	name  string
+	calls int
+}
+
+func (o *countingObserver) GetName() string { return o.name }
+
+func (o *countingObserver) ObserveUnary(context.Context, interface{}, interface{}, *grpc.UnaryServerInfo, error) {
+	o.calls++
+}
+
+func TestTenantObserverRegistry(t *testing.T) {
+	registry := server.ShowcaseObserverRegistry()
+	tenants := map[string]*countingObserver{}
+	for _, id := range []string{"", "go"} {
+		obs := &countingObserver{name: "sessions/-/tests/0"}
+		tenants[id] = obs
+		(&tenantObserverRegistry{GrpcObserverRegistry: registry, id: id}).RegisterUnaryObserver(obs)
+	}
+
+	handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Identity/GetUser"}
+	for _, ctx := range []context.Context{context.Background(), tenantContext("go"), tenantContext("go"), tenantContext("java")} {
+		registry.UnaryInterceptor(ctx, nil, info, handler)
+	}
+	if tenants[""].calls != 1 || tenants["go"].calls != 2 {
+		t.Errorf("want 1 call observed by the default tenant and 2 by go, got %d and %d", tenants[""].calls, tenants["go"].calls)
+	}
+
+	(&tenantObserverRegistry{GrpcObserverRegistry: registry, id: "go"}).DeleteUnaryObserver("sessions/-/tests/0")
+	registry.UnaryInterceptor(tenantContext("go"), nil, info, handler)
+	if tenants["go"].calls != 2 {
+		t.Errorf("want no calls observed once deleted, got %d", tenants["go"].calls-2)
+	}
+}
+
+func TestSaveState_tenants(t *testing.T) {
+	b := newTenantBackend()
+	if _, err := b.IdentityServer.CreateUser(tenantContext("go"), &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "ekko", Email: "ekko@example.com"},
+	}); err != nil {
+		t.Fatalf("CreateUser: unexpected err %+v", err)
+	}
+	data, err := SaveState(b)
+	if err != nil {
+		t.Fatalf("SaveState: unexpected err %+v", err)
+	}
+
+	restored := newTenantBackend()
+	if err := LoadState(restored, data); err != nil {
+		t.Fatalf("LoadState: unexpected err %+v", err)
+	}
+	if _, err := restored.IdentityServer.GetUser(tenantContext("go"), &pb.GetUserRequest{Name: "users/0"}); err != nil {
+		t.Errorf("GetUser: want the user of the restored tenant, got %v", err)
+	}
+	_, err = restored.IdentityServer.GetUser(context.Background(), &pb.GetUserRequest{Name: "users/0"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetUser: want NotFound in the default tenant, got %v", err)
+	}
+}
@@ -30,12 +30,7 @@ import (
 
 // NewTestingServer returns a new TestingServer for the Showcase API.
 func NewTestingServer(observerRegistry server.GrpcObserverRegistry) pb.TestingServer {
-	name := fmt.Sprintf("sessions/-")
-	defaultSession := server.NewSession(name, pb.Session_V1_LATEST, observerRegistry)
-	defaultSession.RegisterTests(spec.ShowcaseTests(name, pb.Session_V1_LATEST))
-	sessions := []sessionEntry{sessionEntry{session: defaultSession}}
-	keys := map[string]int{name: len(sessions) - 1}
-
+	sessions, keys := defaultSessions(observerRegistry)
 	s := &testingServerImpl{
 		token:            server.NewTokenGenerator(),
 		observerRegistry: observerRegistry,
@@ -46,6 +41,17 @@ func NewTestingServer(observerRegistry server.GrpcObserverRegistry) pb.TestingSe
 	return s
 }
 
+// defaultSessions returns the sessions a testing server starts with,
+// which only contain the default session, along with their keys.
+func defaultSessions(observerRegistry server.GrpcObserverRegistry) ([]sessionEntry, map[string]int) {
+	name := fmt.Sprintf("sessions/-")
+	defaultSession := server.NewSession(name, pb.Session_V1_LATEST, observerRegistry)
+	defaultSession.RegisterTests(spec.ShowcaseTests(name, pb.Session_V1_LATEST))
+	sessions := []sessionEntry{sessionEntry{session: defaultSession}}
+	keys := map[string]int{name: len(sessions) - 1}
+	return sessions, keys
+}
+
 type sessionEntry struct {
 	session server.Session
 	deleted bool
@@ -34,6 +34,7 @@ type Session interface {
 	GetVersion() pb.Session_Version
 	GetReport() *pb.ReportSessionResponse
 	RegisterTests(tests []Test)
+	UnregisterTests()
 	ListTests(in *pb.ListTestsRequest) (*pb.ListTestsResponse, error)
 	DeleteTest(name string) (*empty.Empty, error)
 }
@@ -167,6 +168,11 @@ func (s *sessionImpl) DeleteTest(name string) (*empty.Empty, error) {
 			if _, ok := test.(StreamResponseObserver); ok {
 				s.observerRegistry.DeleteStreamResponseObserver(test.GetName())
 			}
+			if r, ok := s.observerRegistry.(StreamEndObserverRegistry); ok {
+				if _, ok := test.(StreamEndObserver); ok {
+					r.DeleteStreamEndObserver(test.GetName())
+				}
+			}
 
 			return &empty.Empty{}, nil
 		}
@@ -191,5 +197,38 @@ func (s *sessionImpl) RegisterTests(tests []Test) {
 		if obs, ok := test.(StreamResponseObserver); ok {
 			s.observerRegistry.RegisterStreamResponseObserver(obs)
 		}
+		if r, ok := s.observerRegistry.(StreamEndObserverRegistry); ok {
+			if obs, ok := test.(StreamEndObserver); ok {
+				r.RegisterStreamEndObserver(obs)
+			}
+		}
+	}
+}
+
+// UnregisterTests removes the observers of the session's tests from the
+// observer registry, so that the tests no longer observe calls.
+func (s *sessionImpl) UnregisterTests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, entry := range s.tests {
+		if entry.deleted {
+			continue
+		}
+		test := entry.test
+		if _, ok := test.(UnaryObserver); ok {
+			s.observerRegistry.DeleteUnaryObserver(test.GetName())
+		}
+		if _, ok := test.(StreamRequestObserver); ok {
+			s.observerRegistry.DeleteStreamRequestObserver(test.GetName())
+		}
+		if _, ok := test.(StreamResponseObserver); ok {
+			s.observerRegistry.DeleteStreamResponseObserver(test.GetName())
+		}
+		if r, ok := s.observerRegistry.(StreamEndObserverRegistry); ok {
+			if _, ok := test.(StreamEndObserver); ok {
+				r.DeleteStreamEndObserver(test.GetName())
+			}
+		}
 	}
 }
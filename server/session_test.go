@@ -193,6 +193,51 @@ func Test_sessionImpl_TestLifeCycle(t *testing.T) {
 
 }
 
+func Test_sessionImpl_UnregisterTests(t *testing.T) {
+	registry := ShowcaseObserverRegistry().(*showcaseObserverRegistry)
+	session := NewSession("sessions/0", pb.Session_V1_LATEST, registry)
+	session.RegisterTests([]Test{&mockTest{name: "first"}, &mockTest{name: "second"}})
+	if _, err := session.DeleteTest("second"); err != nil {
+		t.Errorf("sessionImpl.DeleteTest() = %v", err)
+	}
+
+	session.UnregisterTests()
+	set := registry.observers.Load().(*observerSet)
+	if n := len(set.uObservers) + len(set.sReqObservers) + len(set.sRespObservers); n != 0 {
+		t.Errorf("sessionImpl.UnregisterTests() left %d observers registered", n)
+	}
+}
+
+// streamEndTest is a test that also observes the end of streams.
+type streamEndTest struct {
+	*mockTest
+}
+
+func (t *streamEndTest) ObserveStreamEnd(ctx context.Context, info *grpc.StreamServerInfo, err error) {
+}
+
+// basicObserverRegistry hides every method of the registry it wraps but those
+// of GrpcObserverRegistry, as do the registries that predate
+// StreamEndObserverRegistry.
+type basicObserverRegistry struct {
+	GrpcObserverRegistry
+}
+
+func Test_sessionImpl_withoutStreamEndObservers(t *testing.T) {
+	registry := ShowcaseObserverRegistry().(*showcaseObserverRegistry)
+	test := &streamEndTest{&mockTest{name: "ends"}}
+	session := NewSession("sessions/0", pb.Session_V1_LATEST, basicObserverRegistry{registry})
+	session.RegisterTests([]Test{test})
+
+	set := registry.observers.Load().(*observerSet)
+	if len(set.uObservers) != 1 || len(set.sEndObservers) != 0 {
+		t.Errorf("got %d unary and %d stream end observers registered, want 1 and 0", len(set.uObservers), len(set.sEndObservers))
+	}
+	if _, err := session.DeleteTest("ends"); err != nil {
+		t.Errorf("sessionImpl.DeleteTest() = %v", err)
+	}
+}
+
 func Test_sessionImpl_GetReport(t *testing.T) {
 	failed := &mockTest{
 		name:        "failedTest",
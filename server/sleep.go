@@ -0,0 +1,56 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// Sleep waits until the server clock has moved forward by d, unless ctx
+// is done first, in which case it returns the status error matching
+// ctx.Err(): DEADLINE_EXCEEDED if the deadline of the call has passed, or
+// CANCELLED if the client has gone away. Deadlines follow the real time,
+// so a call sleeping while the clock is frozen ends at its deadline.
+func Sleep(ctx context.Context, d time.Duration) error {
+	return GetClockInstance().Sleep(ctx, d)
+}
+
+// SleepRealTime waits for d of real time, regardless of the server
+// clock, unless ctx is done first, in which case it returns the status
+// error matching ctx.Err().
+func SleepRealTime(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctxErr(ctx)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctxErr(ctx)
+	}
+}
+
+// ctxErr returns the status error matching ctx.Err(), if any.
+func ctxErr(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
@@ -41,5 +41,6 @@ func v1_0Tests(sessionName string) []server.Test {
 func v1LatestTests(sessionName string) []server.Test {
 	return []server.Test{
 		v1.NewUnaryTest(sessionName),
+		v1.NewCompressionTest(sessionName),
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/googleapis/gapic-showcase/server/compression"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+)
+
+// compressionThreshold is the size of the content from which an Echo
+// request is expected to be compressed.
+const compressionThreshold = 1024
+
+// compressionTest checks that the client compresses large Echo requests
+// when configured to. It is confirmed by the first compressed request
+// whose content is at least compressionThreshold bytes long.
+type compressionTest struct {
+	sessionName string
+
+	mu           sync.Mutex
+	uncompressed int
+	compression  string
+}
+
+func NewCompressionTest(sessionName string) server.Test {
+	return &compressionTest{sessionName: sessionName}
+}
+
+func (t *compressionTest) GetName() string {
+	return fmt.Sprintf("%s/gapic.v1p0.compression.request", t.sessionName)
+}
+
+func (t *compressionTest) GetExpectationLevel() pb.Test_ExpectationLevel {
+	return pb.Test_RECOMMENDED
+}
+
+func (t *compressionTest) GetDescription() string {
+	return fmt.Sprintf("The generator generates clients that can be configured to compress their requests, "+
+		"and compress an Echo request whose content is at least %d bytes long when they are.", compressionThreshold)
+}
+
+func (t *compressionTest) GetBlueprints() []*pb.Test_Blueprint {
+	return []*pb.Test_Blueprint{}
+}
+
+func (t *compressionTest) GetIssue() *pb.Issue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.compression != "" {
+		return nil
+	}
+	if t.uncompressed > 0 {
+		return &pb.Issue{
+			Type:     pb.Issue_PENDING,
+			Severity: pb.Issue_ERROR,
+			Description: fmt.Sprintf("%d Echo requests of at least %d bytes were received uncompressed. "+
+				"Configure the client to compress its requests and make one more.", t.uncompressed, compressionThreshold),
+		}
+	}
+	return &pb.Issue{
+		Type:     pb.Issue_SKIPPED,
+		Severity: pb.Issue_ERROR,
+		Description: fmt.Sprintf("This test has not been started. Make a compressed Echo request "+
+			"whose content is at least %d bytes long to start this test.", compressionThreshold),
+	}
+}
+
+func (t *compressionTest) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	if info.FullMethod != "/google.showcase.v1beta1.Echo/Echo" {
+		return
+	}
+	echoReq, ok := req.(*pb.EchoRequest)
+	if !ok || len(echoReq.GetContent()) < compressionThreshold {
+		return
+	}
+
+	requestCompression, _ := compression.FromContext(ctx)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if requestCompression == "" {
+		t.uncompressed++
+		return
+	}
+	if t.compression == "" {
+		t.compression = requestCompression
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+func Test_compressionTest_GetName(t *testing.T) {
+	got := NewCompressionTest("sessions/-").GetName()
+	want := "sessions/-/gapic.v1p0.compression.request"
+	if got != want {
+		t.Errorf("GetName: got %s, want %s", got, want)
+	}
+}
+
+func Test_compressionTest_GetIssue(t *testing.T) {
+	ct := NewCompressionTest("sessions/-").(*compressionTest)
+	if got := ct.GetIssue().GetType(); got != pb.Issue_SKIPPED {
+		t.Errorf("GetIssue: want SKIPPED before any call, got %v", got)
+	}
+
+	large := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: strings.Repeat("x", compressionThreshold)}}
+	small := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}
+	gzipped := compression.NewContext(context.Background(), compression.Gzip, "")
+	echo := serverInfo("/google.showcase.v1beta1.Echo/Echo")
+
+	ct.ObserveUnary(gzipped, small, nil, echo, nil)
+	ct.ObserveUnary(context.Background(), large, nil, echo, nil)
+	if got := ct.GetIssue().GetType(); got != pb.Issue_PENDING {
+		t.Errorf("GetIssue: want PENDING after an uncompressed request, got %v", got)
+	}
+
+	ct.ObserveUnary(gzipped, large, nil, serverInfo("/google.showcase.v1beta1.Echo/Chat"), nil)
+	if got := ct.GetIssue().GetType(); got != pb.Issue_PENDING {
+		t.Errorf("GetIssue: want PENDING after a call to another method, got %v", got)
+	}
+
+	ct.ObserveUnary(gzipped, large, nil, echo, nil)
+	if got := ct.GetIssue(); got != nil {
+		t.Errorf("GetIssue: want no issue after a compressed request, got %+v", got)
+	}
+}
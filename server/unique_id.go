@@ -27,3 +27,14 @@ type UniqID struct {
 func (u *UniqID) Next() int64 {
 	return atomic.AddInt64(&u.i, 1) - 1
 }
+
+// Peek gets the id that the next call to Next will return, without
+// consuming it.
+func (u *UniqID) Peek() int64 {
+	return atomic.LoadInt64(&u.i)
+}
+
+// Set makes subsequent calls to Next return ids starting from next.
+func (u *UniqID) Set(next int64) {
+	atomic.StoreInt64(&u.i, next)
+}
@@ -23,3 +23,14 @@ func TestUniqID_Next(t *testing.T) {
 		t.Errorf("Next: got %d, want %d", got, 2)
 	}
 }
+
+func TestUniqID_Set(t *testing.T) {
+	u := &UniqID{}
+	u.Set(5)
+	if got := u.Peek(); got != 5 {
+		t.Errorf("Peek: got %d, want %d", got, 5)
+	}
+	if got := u.Next(); got != 5 {
+		t.Errorf("Next: got %d, want %d", got, 5)
+	}
+}
@@ -15,8 +15,7 @@
 package server
 
 import (
-	"encoding/base64"
-	"fmt"
+	"context"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -25,15 +24,22 @@ import (
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 )
 
-var waiterSingleton Waiter = &waiterImpl{
-	nowF: time.Now,
-}
+var waiterSingleton = NewWaiter(operationStoreSingleton)
 
-// GetWaiterInstance returns the waiter singleton.
+// GetWaiterInstance returns the waiter singleton, which registers its
+// operations in the operation store singleton.
 func GetWaiterInstance() Waiter {
 	return waiterSingleton
 }
 
+// NewWaiter returns a Waiter registering its operations in operations.
+func NewWaiter(operations *OperationStore) Waiter {
+	return &waiterImpl{
+		nowF:       clockSingleton.Now,
+		operations: operations,
+	}
+}
+
 // Waiter handles the echo.Wait method for both the LRO service and the echo service.
 type Waiter interface {
 	Wait(req *pb.WaitRequest) *lropb.Operation
@@ -41,13 +47,23 @@ type Waiter interface {
 
 type waiterImpl struct {
 	nowF func() time.Time
+
+	// operations is where the operations are registered, so that they
+	// finish once their end time has passed.
+	operations *OperationStore
 }
 
+// Wait starts an operation named "operations/echo/wait/{id}" that is done
+// at the end time of req. The request is kept in the operation store
+// rather than encoded in the name. Until it is done, the metadata of the
+// operation reports its progress since now.
 func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
+	req = proto.Clone(req).(*pb.WaitRequest)
+	start := w.nowF()
 	endTime := time.Unix(0, 0).UTC()
 	if ttl := req.GetTtl(); ttl != nil {
 		duration, _ := ptypes.Duration(ttl)
-		endTime = w.nowF().Add(duration)
+		endTime = start.Add(duration)
 	}
 	if end := req.GetEndTime(); end != nil {
 		endTime, _ = ptypes.Timestamp(end)
@@ -57,11 +73,20 @@ func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
 		EndTime: endTimeProto,
 	}
 
-	done := w.nowF().After(endTime)
-	reqBytes, _ := proto.Marshal(req)
-	name := fmt.Sprintf(
-		"operations/google.showcase.v1beta1.Echo/Wait/%s",
-		base64.StdEncoding.EncodeToString(reqBytes))
+	name := w.operations.NewName("echo/wait")
+	answer := w.operation(name, req, start)
+	w.operations.Register(answer, func(context.Context, bool) *lropb.Operation {
+		return w.operation(name, req, start)
+	})
+	return answer
+}
+
+// operation returns the state of the named operation for req, whose end
+// time is set, at the current time.
+func (w *waiterImpl) operation(name string, req *pb.WaitRequest, start time.Time) *lropb.Operation {
+	endTime, _ := ptypes.Timestamp(req.GetEndTime())
+	now := w.nowF()
+	done := now.After(endTime)
 	answer := &lropb.Operation{
 		Name: name,
 		Done: done,
@@ -77,9 +102,45 @@ func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
 	}
 
 	if !done {
-		meta, _ := ptypes.MarshalAny(&pb.WaitMetadata{EndTime: endTimeProto})
+		meta, _ := ptypes.MarshalAny(waitMetadata(req, waitProgress(start, now, endTime)))
 		answer.Metadata = meta
 	}
 
 	return answer
 }
+
+// waitProgress returns the percentage of the time from start to end that
+// has elapsed at now.
+func waitProgress(start, now, end time.Time) int32 {
+	if !end.After(start) || !now.Before(end) {
+		return 100
+	}
+	if now.Before(start) {
+		return 0
+	}
+	return int32(100 * now.Sub(start) / end.Sub(start))
+}
+
+// waitMetadata returns the metadata of the operation for req at the given
+// progress: its current stage and the part of its response produced so
+// far.
+func waitMetadata(req *pb.WaitRequest, progress int32) *pb.WaitMetadata {
+	meta := &pb.WaitMetadata{
+		EndTime:         req.GetEndTime(),
+		ProgressPercent: progress,
+	}
+	if stages := req.GetStages(); len(stages) > 0 {
+		i := len(stages) * int(progress) / 100
+		if i >= len(stages) {
+			i = len(stages) - 1
+		}
+		meta.Stage = stages[i]
+	}
+	if success := req.GetSuccess(); success != nil {
+		content := []rune(success.GetContent())
+		meta.PartialResponse = &pb.WaitResponse{
+			Content: string(content[:len(content)*int(progress)/100]),
+		}
+	}
+	return meta
+}
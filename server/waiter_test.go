@@ -15,16 +15,18 @@
 package server
 
 import (
-	"encoding/base64"
-	"strings"
+	"context"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/genproto/googleapis/rpc/status"
 )
 
@@ -56,14 +58,14 @@ func TestWait_pending(t *testing.T) {
 	}
 
 	for _, req := range tests {
-		waiter := &waiterImpl{nowF: nowF}
+		waiter := &waiterImpl{nowF: nowF, operations: NewOperationStore()}
 		op := waiter.Wait(req)
 
 		if op.Done {
 			t.Errorf("Wait() for %q expectee done=false got done=true", req)
 		}
 
-		checkName(t, req, op)
+		checkName(t, waiter, op)
 
 		if op.Metadata == nil {
 			t.Errorf("Wait() for %q expected metadata, got nil", req)
@@ -92,10 +94,10 @@ func TestWait_success(t *testing.T) {
 		Response: &pb.WaitRequest_Success{Success: success},
 	}
 
-	waiter := &waiterImpl{nowF: nowF}
+	waiter := &waiterImpl{nowF: nowF, operations: NewOperationStore()}
 	op := waiter.Wait(req)
 
-	checkName(t, req, op)
+	checkName(t, waiter, op)
 
 	if !op.Done {
 		t.Errorf("Wait() for %q expected done=true got done=false", req)
@@ -127,10 +129,10 @@ func TestWait_error(t *testing.T) {
 		Response: &pb.WaitRequest_Error{Error: expErr},
 	}
 
-	waiter := &waiterImpl{nowF: nowF}
+	waiter := &waiterImpl{nowF: nowF, operations: NewOperationStore()}
 	op := waiter.Wait(req)
 
-	checkName(t, req, op)
+	checkName(t, waiter, op)
 
 	if !op.Done {
 		t.Errorf("Wait() for %q expected done=true got done=false", req)
@@ -146,28 +148,90 @@ func TestWait_error(t *testing.T) {
 	}
 }
 
+func TestWait_progress(t *testing.T) {
+	clock := NewClock()
+	clock.Freeze()
+	waiter := &waiterImpl{nowF: clock.Now, operations: NewOperationStore()}
+	req := &pb.WaitRequest{
+		End:      &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(4 * time.Second)},
+		Response: &pb.WaitRequest_Success{Success: &pb.WaitResponse{Content: "Hello World!"}},
+		Stages:   []string{"PREPARING", "RUNNING"},
+	}
+	op := waiter.Wait(req)
+
+	tests := []struct {
+		advance  time.Duration
+		progress int32
+		stage    string
+		partial  string
+	}{
+		{0, 0, "PREPARING", ""},
+		{time.Second, 25, "PREPARING", "Hel"},
+		{time.Second, 50, "RUNNING", "Hello "},
+		{time.Second + time.Second/2, 87, "RUNNING", "Hello Worl"},
+	}
+	for _, test := range tests {
+		clock.Advance(test.advance)
+		got, err := waiter.operations.Get(context.Background(), op.GetName())
+		if err != nil || got.GetDone() {
+			t.Fatalf("Wait() expected a pending operation, got %+v, %v", got, err)
+		}
+		meta := &pb.WaitMetadata{}
+		ptypes.UnmarshalAny(got.GetMetadata(), meta)
+		if meta.GetProgressPercent() != test.progress ||
+			meta.GetStage() != test.stage ||
+			meta.GetPartialResponse().GetContent() != test.partial {
+			t.Errorf(
+				"Wait() expected progress=%d stage=%q partial=%q, got %q",
+				test.progress,
+				test.stage,
+				test.partial,
+				meta)
+		}
+	}
+
+	clock.Advance(time.Second)
+	got, _ := waiter.operations.Get(context.Background(), op.GetName())
+	if !got.GetDone() || got.GetResponse() == nil {
+		t.Errorf("Wait() expected a finished operation, got %+v", got)
+	}
+}
+
+func TestWait_errorDetails(t *testing.T) {
+	nowF := func() time.Time { return time.Unix(3, 0) }
+	errorInfo, _ := ptypes.MarshalAny(&errdetails.ErrorInfo{Reason: "STOCKOUT", Domain: "showcase.googleapis.com"})
+	retryInfo, _ := ptypes.MarshalAny(&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(time.Second)})
+	expErr := &status.Status{
+		Code:    int32(14),
+		Message: "Unavailable!",
+		Details: []*any.Any{errorInfo, retryInfo},
+	}
+	req := &pb.WaitRequest{
+		End:      &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(2, 0))},
+		Response: &pb.WaitRequest_Error{Error: expErr},
+	}
+
+	waiter := &waiterImpl{nowF: nowF, operations: NewOperationStore()}
+	op := waiter.Wait(req)
+	if !proto.Equal(expErr, op.GetError()) {
+		t.Errorf("Wait() expected op.Error=%q, got %q", expErr, op.GetError())
+	}
+}
+
 func timestampProto(t time.Time) *timestamp.Timestamp {
 	ts, _ := ptypes.TimestampProto(t)
 	return ts
 }
 
-func checkName(t *testing.T, req *pb.WaitRequest, op *lropb.Operation) {
-	if !strings.HasPrefix(op.Name, "operations/google.showcase.v1beta1.Echo/Wait/") {
-		t.Errorf(
-			"Wait() expected op.Name prefex 'operations/google.showcase.v1beta1.Echo/Wait/', got: %s'",
-			op.Name)
-	}
-	nameProto := &pb.WaitRequest{}
-	encodedBytes := strings.TrimPrefix(
-		op.Name,
-		"operations/google.showcase.v1beta1.Echo/Wait/")
-	bytes, _ := base64.StdEncoding.DecodeString(encodedBytes)
-	proto.Unmarshal(bytes, nameProto)
-	if !proto.Equal(nameProto, req) {
-		t.Errorf(
-			"Wait() for %q expected unmarshalled name=%q, got name=%q",
-			req,
-			req,
-			nameProto)
+func checkName(t *testing.T, waiter *waiterImpl, op *lropb.Operation) {
+	if !regexp.MustCompile(`^operations/echo/wait/[0-9a-f]{8}-\d+$`).MatchString(op.Name) {
+		t.Errorf("Wait() expected an opaque op.Name such as 'operations/echo/wait/5f2c9a1e-1', got: %s", op.Name)
+	}
+	stored, err := waiter.operations.Get(context.Background(), op.Name)
+	if err != nil {
+		t.Fatalf("Wait() expected %q to be stored, got err %+v", op.Name, err)
+	}
+	if !proto.Equal(stored, op) {
+		t.Errorf("Wait() for %q expected the stored operation %q, got %q", op.Name, op, stored)
 	}
 }
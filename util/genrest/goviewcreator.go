@@ -157,11 +157,12 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 			file.P("  requestJSON, _ := marshaler.MarshalToString(%s)", handler.RequestVariable)
 			file.P(`  backend.StdLog.Printf("  request: %%s", requestJSON)`)
 			file.P("")
-			// TODO: In the future, we may want to redirect all REST-endpoint requests to the gRPC endpoint so that the gRPC-registered observers get invoked.
-			file.P("  %s, err := backend.%sServer.%s(context.Background(), %s)", handler.ResponseVariable, service.ShortName, handler.GoMethod, handler.RequestVariable)
+			file.P("  %s, err := resttools.InvokeUnary(resttools.IncomingContext(r), backend.UnaryInterceptor, backend.%sServer, %q, %s,", handler.ResponseVariable, service.ShortName, fullMethodName(service, handler), handler.RequestVariable)
+			file.P("    func(ctx context.Context, req interface{}) (interface{}, error) {")
+			file.P("      return backend.%sServer.%s(ctx, req.(*%s.%s))", service.ShortName, handler.GoMethod, handler.RequestTypePackage, handler.RequestType)
+			file.P("    })")
 			file.P("  if err != nil {")
-			file.P("    // TODO: Properly handle error")
-			file.P("    w.Write([]byte(err.Error()))")
+			file.P("    resttools.ErrorResponse(w, err)")
 			file.P("    return")
 			file.P("  }")
 			file.P("")
@@ -186,6 +187,7 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 	file.P("")
 	file.P("import (")
 	file.P(`   "github.com/googleapis/gapic-showcase/server/services"`)
+	file.P(`   "github.com/googleapis/gapic-showcase/util/genrest/resttools"`)
 	file.P("")
 	file.P(`  gmux "github.com/gorilla/mux"`)
 	file.P(")")
@@ -203,7 +205,7 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 	// registering other handlers with the same URL pattern.) Consider using gorilla/mux
 	// subroutes, selecting by HTTP verb before the URL path.
 	for _, handler := range registered {
-		file.P(`  router.HandleFunc(%q, rest.%s).Methods(%q)`, handler.pattern, handler.function, handler.verb)
+		file.P(`  router.HandleFunc(%q, resttools.WithCompression(rest.%s)).Methods(%q)`, handler.pattern, handler.function, handler.verb)
 	}
 	file.P(`}`)
 	file.P("")
@@ -211,6 +213,12 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 	return view, nil
 }
 
+// fullMethodName returns the gRPC name of the method (e.g. "/google.showcase.v1beta1.Echo/Echo")
+// served by handler, as it appears in grpc.UnaryServerInfo.
+func fullMethodName(service *gomodel.ServiceModel, handler *gomodel.RESTHandler) string {
+	return fmt.Sprintf("/%s/%s", strings.TrimPrefix(service.ProtoPath, "."), handler.GoMethod)
+}
+
 // registeredHandler pairs a URL path pattern with the name of the associated handler
 type registeredHandler struct {
 	pattern  string // URL pattern
@@ -0,0 +1,92 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithCompression wraps handler so that it accepts request bodies
+// compressed as per their Content-Encoding header and compresses its
+// responses as per the Accept-Encoding header, using the compressors
+// enabled by compression.Register. The compression used is recorded
+// in the request context, where compression.FromContext finds it.
+func WithCompression(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestCompression := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+		if requestCompression == "identity" {
+			requestCompression = ""
+		}
+		if requestCompression != "" {
+			body, err := compression.Decompress(requestCompression, r.Body)
+			if err != nil {
+				ErrorResponse(w, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+			r.Body = ioutil.NopCloser(body)
+			r.Header.Del("Content-Length")
+			r.ContentLength = -1
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		responseCompression := compression.NegotiateEncoding(r.Header.Get("Accept-Encoding"))
+		r = r.WithContext(compression.NewContext(r.Context(), requestCompression, responseCompression))
+		if responseCompression == "" {
+			handler(w, r)
+			return
+		}
+
+		cw := &compressedResponseWriter{ResponseWriter: w, compression: responseCompression}
+		defer cw.close()
+		handler(cw, r)
+	}
+}
+
+// compressedResponseWriter compresses the body of a response.
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	compression string
+	writer      io.WriteCloser
+}
+
+func (cw *compressedResponseWriter) WriteHeader(code int) {
+	if cw.writer == nil {
+		cw.Header().Set("Content-Encoding", cw.compression)
+		cw.Header().Del("Content-Length")
+		cw.writer, _ = compression.Compress(cw.compression, cw.ResponseWriter)
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *compressedResponseWriter) Write(data []byte) (int, error) {
+	if cw.writer == nil {
+		cw.WriteHeader(http.StatusOK)
+	}
+	return cw.writer.Write(data)
+}
+
+// close flushes the compressed body, if any was written.
+func (cw *compressedResponseWriter) close() {
+	if cw.writer != nil {
+		cw.writer.Close()
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server/compression"
+)
+
+func TestWithCompression(t *testing.T) {
+	if err := compression.Register([]string{compression.Gzip}); err != nil {
+		t.Fatalf("Register: unexpected err %+v", err)
+	}
+	var gotBody, gotRequest, gotResponse string
+	handler := WithCompression(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		gotRequest, gotResponse = compression.FromContext(r.Context())
+		w.Write([]byte(`{"content":"hello"}`))
+	})
+
+	compressed := &bytes.Buffer{}
+	gw := gzip.NewWriter(compressed)
+	gw.Write([]byte(`{"content":"hello"}`))
+	gw.Close()
+	req := httptest.NewRequest(http.MethodPost, "/v1beta1/echo:echo", compressed)
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if gotBody != `{"content":"hello"}` || gotRequest != "gzip" || gotResponse != "gzip" {
+		t.Errorf("WithCompression: handler got body %q, compression %q and %q", gotBody, gotRequest, gotResponse)
+	}
+	if got := rec.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("WithCompression: want a gzip response, got Content-Encoding %q", got)
+	}
+	gr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("WithCompression: invalid gzip response: %v", err)
+	}
+	if body, _ := ioutil.ReadAll(gr); string(body) != `{"content":"hello"}` {
+		t.Errorf("WithCompression: got response %q", body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1beta1/echo:echo", strings.NewReader("{}"))
+	req.Header.Set("Content-Encoding", "br")
+	rec = httptest.NewRecorder()
+	handler(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("WithCompression: want 400 for an unsupported encoding, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1beta1/echo:echo", strings.NewReader("{}"))
+	rec = httptest.NewRecorder()
+	handler(rec, req)
+	if got := rec.Header().Get("Content-Encoding"); got != "" || rec.Body.String() != `{"content":"hello"}` {
+		t.Errorf("WithCompression: want an uncompressed response, got %q encoded as %q", rec.Body.String(), got)
+	}
+}
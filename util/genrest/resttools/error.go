@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Registers the standard error details, so that they can be
+	// written as JSON.
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// httpStatus maps gRPC status codes to HTTP status codes, as documented in
+// google/rpc/code.proto.
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+}
+
+// HTTPStatus returns the HTTP status code matching the gRPC status code c.
+func HTTPStatus(c codes.Code) int {
+	if s, ok := httpStatus[c]; ok {
+		return s
+	}
+	return http.StatusInternalServerError
+}
+
+// errorBody is the JSON representation of an error response, as described
+// in https://cloud.google.com/apis/design/errors#http_mapping.
+type errorBody struct {
+	Error struct {
+		Code    int               `json:"code"`
+		Message string            `json:"message"`
+		Status  string            `json:"status"`
+		Details []json.RawMessage `json:"details,omitempty"`
+	} `json:"error"`
+}
+
+// ErrorResponse writes err to w as a JSON error response with the HTTP
+// status matching its gRPC status, including its status details.
+func ErrorResponse(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body := errorBody{}
+	body.Error.Code = HTTPStatus(st.Code())
+	body.Error.Message = st.Message()
+	body.Error.Status = codeName(st.Code())
+
+	marshaler := &jsonpb.Marshaler{}
+	for _, detail := range st.Proto().GetDetails() {
+		detailJSON, err := marshaler.MarshalToString(detail)
+		if err != nil {
+			continue
+		}
+		body.Error.Details = append(body.Error.Details, json.RawMessage(detailJSON))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(body.Error.Code)
+	json.NewEncoder(w).Encode(body)
+}
+
+// codeName returns the name of c as in google/rpc/code.proto, such as
+// "NOT_FOUND".
+func codeName(c codes.Code) string {
+	return code.Code(c).String()
+}
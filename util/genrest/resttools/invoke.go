@@ -0,0 +1,63 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// IncomingContext returns the context of r carrying its HTTP headers as incoming gRPC metadata, so
+// that server-side behavior relying on metadata.FromIncomingContext applies to REST calls as well.
+func IncomingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		key = strings.ToLower(key)
+		md[key] = append(md[key], values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+// InvokeUnary calls handler with request, going through interceptor (if not nil) the same way a
+// grpc.Server would for a unary call to the RPC named by fullMethod (e.g.
+// "/google.showcase.v1beta1.Echo/Echo") on server. This allows the REST endpoint to share the
+// server-side behavior, such as fault injection, configured for the gRPC endpoint.
+func InvokeUnary(ctx context.Context, interceptor grpc.UnaryServerInterceptor, server interface{}, fullMethod string, request interface{}, handler grpc.UnaryHandler) (proto.Message, error) {
+	var (
+		response interface{}
+		err      error
+	)
+	if interceptor == nil {
+		response, err = handler(ctx, request)
+	} else {
+		info := &grpc.UnaryServerInfo{Server: server, FullMethod: fullMethod}
+		response, err = interceptor(ctx, request, info, handler)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := response.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response for %s is not a proto message: %T", fullMethod, response)
+	}
+	return message, nil
+}